@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt word1 word2")
+	fmt.Println("example :\t", programName, "./assets/app/small_en.txt cat dog")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	dictionaryOptions.RegisterFrequencyFlags(flag.CommandLine)
+	traceOptions := &wordchainscli.TraceOptions{}
+	traceOptions.RegisterFlags(flag.CommandLine)
+	queryOptions := &wordchainscli.QueryOptions{}
+	queryOptions.RegisterFlags(flag.CommandLine)
+	heuristicOptions := &wordchainscli.HeuristicOptions{}
+	heuristicOptions.RegisterFlags(flag.CommandLine)
+	width := flag.Int("width", 10, "number of nodes kept at each depth, 0 to keep every node")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 3 {
+		usage(programName)
+		return
+	}
+	filePath := args[0]
+	word1 := args[1]
+	word2 := args[2]
+	solver := wordchainsresolver.NewBeamSolver(*width)
+	factory, err := dictionaryOptions.NewFactory(filePath)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	err = traceOptions.Observe(solver, os.Stdout)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	constraints, err := queryOptions.QueryOptions()
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	err = heuristicOptions.Apply(solver)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(solver, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	err = heuristicOptions.ApplyLandmarks(wcr, solver)
+	if err != nil {
+		fmt.Println("error while loading landmarks :", err)
+		return
+	}
+	err = dictionaryOptions.ApplyFrequencies(wcr)
+	if err != nil {
+		fmt.Println("error while loading frequency list :", err)
+		return
+	}
+	if !wcr.IsWordInDB(word1) {
+		fmt.Println(word1, "is not in your database")
+		return
+	}
+	if !wcr.IsWordInDB(word2) {
+		fmt.Println(word2, "is not in your database")
+		return
+	}
+	fmt.Println("looking for word chains from", word1, "to", word2+", please wait ...")
+	path, err := wcr.Solve(word1, word2, constraints...)
+	if err != nil {
+		fmt.Println("error while solving word chains :", err)
+		return
+	}
+	wordchainscli.PrintSolutions(os.Stdout, wcr.DisplayChains(path))
+	err = traceOptions.Report(os.Stdout)
+	if err != nil {
+		fmt.Println("error while writing search events :", err)
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] inspect|prune|warm path/to/wordlist.txt [path/to/pairs.txt]")
+	fmt.Println("example :\t", programName, "warm ./assets/app/small_en.txt pairs.txt")
+	fmt.Println("example :\t", programName, "-max-solutions 100 prune ./assets/app/small_en.txt")
+	fmt.Println("pairs file :\t one pair of words per line, separated by a space")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func inspect(store *wordchainsresolver.SolutionStore, listCount int) {
+	fmt.Println("dictionary checksum :", store.Checksum())
+	if store.Invalidated() {
+		fmt.Println("the store was written for another dictionary, its solutions will be dropped at the next save")
+	}
+	solutions := store.Solutions()
+	countsBySolver := make(map[string]int)
+	for _, solution := range solutions {
+		countsBySolver[solution.Solver]++
+	}
+	fmt.Println("stored solutions :", len(solutions))
+	for _, name := range wordchainscli.SolverNames {
+		if countsBySolver[name] != 0 {
+			fmt.Println("\t"+name, ":", countsBySolver[name])
+		}
+	}
+	for index, solution := range solutions {
+		if index == listCount {
+			fmt.Println("...")
+			break
+		}
+		steps := "no solution"
+		if len(solution.Chains) != 0 {
+			steps = fmt.Sprint(len(solution.Chains[0])-1, " step(s), ", len(solution.Chains), " chain(s)")
+		}
+		fmt.Println(solution.SavedAt.Format(time.RFC3339), solution.Solver, solution.From, "->", solution.To, ":", steps)
+	}
+}
+
+func readPairs(path string) ([][2]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var pairs [][2]string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		words := strings.Fields(scanner.Text())
+		if len(words) != 2 {
+			continue
+		}
+		pairs = append(pairs, [2]string{words[0], words[1]})
+	}
+	return pairs, scanner.Err()
+}
+
+func warm(wcr *wordchainsresolver.WordChainsResolver, pairs [][2]string) {
+	solved := 0
+	for _, pair := range pairs {
+		if !wcr.IsWordInDB(pair[0]) || !wcr.IsWordInDB(pair[1]) {
+			fmt.Println("skipping", pair[0], "->", pair[1], ": word not in your database")
+			continue
+		}
+		_, err := wcr.Solve(pair[0], pair[1])
+		if err != nil {
+			fmt.Println("skipping", pair[0], "->", pair[1], ":", err)
+			continue
+		}
+		solved++
+	}
+	fmt.Println("solved", solved, "pair(s) out of", len(pairs))
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	dictionaryOptions.RegisterFrequencyFlags(flag.CommandLine)
+	storePath := flag.String("store", "", "path to the solution store, the word list path followed by .solutions.json if empty")
+	solverName := flag.String("solver", "astar", "solver used to warm the store among "+strings.Join(wordchainscli.SolverNames, ", "))
+	olderThan := flag.Duration("older-than", 0, "prune solutions saved longer ago than this duration, e.g. 720h")
+	maxSolutions := flag.Int("max-solutions", 0, "prune the oldest solutions beyond this number, 0 for no limit")
+	listCount := flag.Int("list", 20, "maximum number of solutions listed by inspect")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) < 2 || (args[0] == "warm") != (len(args) == 3) || len(args) > 3 {
+		usage(programName)
+		return
+	}
+	command, filePath := args[0], args[1]
+	if command != "inspect" && command != "prune" && command != "warm" {
+		usage(programName)
+		return
+	}
+	if *storePath == "" {
+		*storePath = filePath + ".solutions.json"
+	}
+	solver, err := wordchainscli.NewSolver(*solverName)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(filePath)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	cache := wordchainsresolver.NewCachingSolver(solver, *solverName, 1000)
+	wcr := wordchainsresolver.NewWordChainsResolver(cache, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	err = dictionaryOptions.ApplyFrequencies(wcr)
+	if err != nil {
+		fmt.Println("error while loading frequency list :", err)
+		return
+	}
+	store, err := wordchainsresolver.OpenSolutionStore(*storePath, wcr.DictionaryChecksum())
+	if err != nil {
+		fmt.Println("error while opening solution store :", err)
+		return
+	}
+	cache.UseStore(store)
+
+	switch command {
+	case "inspect":
+		inspect(store, *listCount)
+		return
+	case "prune":
+		var olderThanTime time.Time
+		if *olderThan > 0 {
+			olderThanTime = time.Now().Add(-*olderThan)
+		}
+		fmt.Println("pruned", store.Prune(olderThanTime, *maxSolutions), "solution(s)")
+	case "warm":
+		pairs, err := readPairs(args[2])
+		if err != nil {
+			fmt.Println("error while reading pairs :", err)
+			return
+		}
+		warm(wcr, pairs)
+		stats := cache.Stats()
+		fmt.Println("already solved :", stats.Hits, "- newly solved :", stats.Misses)
+	}
+	err = store.Save()
+	if err != nil {
+		fmt.Println("error while saving solution store :", err)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt word1 word2 ... wordN")
+	fmt.Println("example :\t", programName, "-to dog ./assets/app/small_en.txt cat cot cog dog")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	from := flag.String("from", "", "word the chain must start with, the first word of the chain if empty")
+	to := flag.String("to", "", "word the chain must end with, the last word of the chain if empty")
+	expectedSteps := flag.Int("steps", 0, "number of steps the chain must have, any number if 0")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) < 2 {
+		usage(programName)
+		return
+	}
+	chain := args[1:]
+	if *from == "" {
+		*from = chain[0]
+	}
+	if *to == "" {
+		*to = chain[len(chain)-1]
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	report, err := wcr.CheckChain(chain, *from, *to, *expectedSteps)
+	if err != nil {
+		fmt.Println("error while checking word chain :", err)
+		return
+	}
+	fmt.Println(report)
+	if !report.Valid {
+		os.Exit(1)
+	}
+}
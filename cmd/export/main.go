@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt")
+	fmt.Println("example :\t", programName, "-format gexf -length 3 ./assets/app/small_en.txt")
+	fmt.Println("example :\t", programName, "-word cat -steps 2 ./assets/app/small_en.txt")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	formatName := flag.String("format", "dot", "export format among dot, graphml and gexf")
+	wordLength := flag.Int("length", 0, "export every word of this length")
+	word := flag.String("word", "", "export the neighborhood of this word")
+	steps := flag.Int("steps", 2, "maximum number of steps from -word")
+	output := flag.String("output", "", "path to the file to write, standard output if empty")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 || (*wordLength == 0) == (*word == "") {
+		usage(programName)
+		return
+	}
+	format, err := wordchainsresolver.ParseGraphFormat(*formatName)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	graph := wcr.Graph()
+	var words []string
+	if *word != "" {
+		if !wcr.IsWordInDB(*word) {
+			fmt.Println(*word, "is not in your database")
+			return
+		}
+		words = graph.Neighborhood(wcr.Normalize(*word), *steps)
+	} else {
+		words = graph.WordsOfLength(*wordLength)
+	}
+	outputFile := os.Stdout
+	if *output != "" {
+		outputFile, err = os.Create(*output)
+		if err != nil {
+			fmt.Println("error while creating output file :", err)
+			return
+		}
+		defer outputFile.Close()
+	}
+	err = wordchainsresolver.ExportGraph(outputFile, graph, words, format)
+	if err != nil {
+		fmt.Println("error while exporting graph :", err)
+	}
+}
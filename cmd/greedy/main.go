@@ -1,38 +1,42 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
 	"os"
-	"strings"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
 )
 
 func usage(programName string) {
-	fmt.Println("usage :\t\t", programName, "path/to/wordlist.txt word1 word2")
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt word1 word2")
 	fmt.Println("example :\t", programName, "./assets/app/small_en.txt cat dog")
-}
-
-func printSolutions(solutions [][]string) {
-	if len(solutions) == 0 {
-		fmt.Println("no solution found")
-		return
-	}
-	fmt.Println("found", len(solutions), "solution(s)")
-	for index, chain := range solutions {
-		fmt.Print("solution #", index+1, " : ")
-		for chainIndex, word := range chain {
-			fmt.Print(word)
-			if chainIndex == len(chain)-1 {
-				continue
-			}
-			fmt.Print(" -> ")
-		}
-		fmt.Println()
-	}
+	fmt.Println("options :")
+	flag.PrintDefaults()
 }
 
 func main() {
-	programName, args := os.Args[0], os.Args[1:]
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	dictionaryOptions.RegisterFrequencyFlags(flag.CommandLine)
+	traceOptions := &wordchainscli.TraceOptions{}
+	traceOptions.RegisterFlags(flag.CommandLine)
+	queryOptions := &wordchainscli.QueryOptions{}
+	queryOptions.RegisterFlags(flag.CommandLine)
+	heuristicOptions := &wordchainscli.HeuristicOptions{}
+	heuristicOptions.RegisterFlags(flag.CommandLine)
+	treeOptions := &wordchainscli.SearchTreeOptions{}
+	treeOptions.RegisterFlags(flag.CommandLine)
+	greedyOptions := wordchainsresolver.DefaultGreedyOptions()
+	flag.IntVar(&greedyOptions.MaxDepth, "max-depth", greedyOptions.MaxDepth, "depth from which lower scores are not tried anymore, 0 for three times the word length")
+	flag.IntVar(&greedyOptions.ScoreTolerance, "tolerance", greedyOptions.ScoreTolerance, "number of lower score levels tried when no word improves the score")
+	flag.IntVar(&greedyOptions.MaxBacktracks, "backtracks", greedyOptions.MaxBacktracks, "number of times a branch without solution makes its parent try lower scores")
+	flag.IntVar(&greedyOptions.NodeBudget, "node-budget", greedyOptions.NodeBudget, "maximum number of nodes created, 0 for no limit")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
 	if len(args) != 3 {
 		usage(programName)
 		return
@@ -40,27 +44,70 @@ func main() {
 	filePath := args[0]
 	word1 := args[1]
 	word2 := args[2]
-	solver := wordchainsresolver.NewGreedySolver()
-	factory := wordchainsresolver.NewFileLoaderFactory(filePath)
+	solver := wordchainsresolver.NewGreedySolverWithOptions(greedyOptions)
+	err := treeOptions.Record(solver)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(filePath)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	err = traceOptions.Observe(solver, os.Stdout)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	constraints, err := queryOptions.QueryOptions()
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	err = heuristicOptions.Apply(solver)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
 	wcr := wordchainsresolver.NewWordChainsResolver(solver, factory)
-	err := wcr.LoadDB()
+	err = wcr.LoadDB()
 	if err != nil {
 		fmt.Println("error while loading word list :", err)
 		return
 	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	err = heuristicOptions.ApplyLandmarks(wcr, solver)
+	if err != nil {
+		fmt.Println("error while loading landmarks :", err)
+		return
+	}
+	err = dictionaryOptions.ApplyFrequencies(wcr)
+	if err != nil {
+		fmt.Println("error while loading frequency list :", err)
+		return
+	}
 	if !wcr.IsWordInDB(word1) {
 		fmt.Println(word1, "is not in your database")
 		return
 	}
-	if !wcr.IsWordInDB(args[2]) {
-		fmt.Println(args[2], "is not in your database")
+	if !wcr.IsWordInDB(word2) {
+		fmt.Println(word2, "is not in your database")
 		return
 	}
 	fmt.Println("looking for word chains from", word1, "to", word2+", please wait ...")
-	path, err := wcr.Solve(strings.ToLower(word1), strings.ToLower(word2))
+	path, err := wcr.Solve(word1, word2, constraints...)
 	if err != nil {
 		fmt.Println("error while solving word chains :", err)
 		return
 	}
-	printSolutions(path)
+	wordchainscli.PrintSolutions(os.Stdout, wcr.DisplayChains(path))
+	err = traceOptions.Report(os.Stdout)
+	if err != nil {
+		fmt.Println("error while writing search events :", err)
+	}
+	err = treeOptions.Write(solver)
+	if err != nil {
+		fmt.Println("error while writing search tree :", err)
+	}
 }
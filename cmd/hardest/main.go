@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt")
+	fmt.Println("example :\t", programName, "-top 3 ./assets/app/small_en.txt")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func printComponentDiameter(diameter wordchainsresolver.ComponentDiameter) {
+	precision := "exact"
+	if !diameter.Exact {
+		precision = "lower bound"
+	}
+	fmt.Println("\tcomponent", diameter.Component, "("+fmt.Sprint(diameter.Size), "words) : longest chain of",
+		diameter.Diameter, "step(s),", precision)
+	for _, pair := range diameter.Pairs {
+		fmt.Println("\t\t" + strings.Join(pair.Chain, " -> "))
+	}
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	options := wordchainsresolver.DefaultHardestPairsOptions()
+	flag.IntVar(&options.TopPairs, "top", options.TopPairs, "maximum number of pairs to print per component")
+	flag.IntVar(&options.MinComponentSize, "min-component", options.MinComponentSize, "ignore components having less words")
+	flag.IntVar(&options.ExactLimit, "exact-limit", options.ExactLimit, "maximum component size searched from every word")
+	flag.IntVar(&options.CandidateLimit, "candidates", options.CandidateLimit, "maximum number of candidate words searched from in bigger components")
+	componentCount := flag.Int("components", 3, "maximum number of components to print per word length")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		usage(programName)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	fmt.Println("looking for the hardest pairs, please wait ...")
+	printedComponents := 0
+	currentLength := 0
+	for _, diameter := range wcr.HardestPairs(options) {
+		if diameter.WordLength != currentLength {
+			currentLength = diameter.WordLength
+			printedComponents = 0
+			fmt.Println("words of", currentLength, "letter(s) :")
+		}
+		if printedComponents == *componentCount {
+			continue
+		}
+		printedComponents++
+		printComponentDiameter(diameter)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt")
+	fmt.Println("example :\t", programName, "-count 8 ./assets/app/small_en.txt")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	count := flag.Int("count", 4, "number of landmarks per connected component")
+	minComponentSize := flag.Int("min-component-size", 10, "smallest connected component given landmarks")
+	outputPath := flag.String("output", "", "path to the landmarks file, the word list path followed by .landmarks.json if empty")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		usage(programName)
+		return
+	}
+	filePath := args[0]
+	if *outputPath == "" {
+		*outputPath = filePath + ".landmarks.json"
+	}
+	factory, err := dictionaryOptions.NewFactory(filePath)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	fmt.Println("walking the graph from landmarks, please wait ...")
+	heuristic := wcr.PrecomputeLandmarks(*count, *minComponentSize)
+	fmt.Println("components with landmarks :", len(heuristic.Components))
+	fmt.Println("landmarks :", len(heuristic.Landmarks()))
+	file, err := os.Create(*outputPath)
+	if err != nil {
+		fmt.Println("error while creating landmarks file :", err)
+		return
+	}
+	defer file.Close()
+	err = heuristic.Save(file)
+	if err != nil {
+		fmt.Println("error while saving landmarks :", err)
+		return
+	}
+	fmt.Println("landmarks saved to", *outputPath)
+}
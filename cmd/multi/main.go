@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt")
+	fmt.Println("example :\t", programName, "-from cat,dog,pig -to red,tan -all ./assets/app/small_en.txt")
+	fmt.Println("example :\t", programName, "-from cat -to-pattern '^g..$' ./assets/app/small_en.txt")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func splitWords(words string) []string {
+	var wordList []string
+	for _, word := range strings.Split(words, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			wordList = append(wordList, word)
+		}
+	}
+	return wordList
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	dictionaryOptions.RegisterFrequencyFlags(flag.CommandLine)
+	sources := flag.String("from", "", "comma separated first words")
+	goals := flag.String("to", "", "comma separated last words")
+	goalPattern := flag.String("to-pattern", "", "regular expression matching last words")
+	allGoals := flag.Bool("all", false, "give a word chain to every last word at the optimal distance")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		usage(programName)
+		return
+	}
+	query := wordchainsresolver.MultiQuery{
+		Sources:  splitWords(*sources),
+		Goals:    splitWords(*goals),
+		AllGoals: *allGoals,
+	}
+	if *goalPattern != "" {
+		pattern, err := regexp.Compile(*goalPattern)
+		if err != nil {
+			fmt.Println("error while reading options :", err)
+			return
+		}
+		query.GoalPattern = pattern
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	err = dictionaryOptions.ApplyFrequencies(wcr)
+	if err != nil {
+		fmt.Println("error while loading frequency list :", err)
+		return
+	}
+	for _, word := range append(query.Sources, query.Goals...) {
+		if !wcr.IsWordInDB(word) {
+			fmt.Println(word, "is not in your database")
+			return
+		}
+	}
+	solutions, err := wcr.SolveMulti(query)
+	if err != nil {
+		fmt.Println("error while solving word chains :", err)
+		return
+	}
+	wordchainscli.PrintSolutions(os.Stdout, wcr.DisplayChains(solutions))
+}
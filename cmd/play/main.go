@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt [word1 word2]")
+	fmt.Println("example :\t", programName, "./assets/app/small_en.txt cat dog")
+	fmt.Println("example :\t", programName, "-difficulty easy -length 4 ./assets/app/small_en.txt")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func display(wcr *wordchainsresolver.WordChainsResolver, words ...string) []string {
+	return wcr.DisplayChains([][]string{words})[0]
+}
+
+func pickPair(wcr *wordchainsresolver.WordChainsResolver, difficulty string, wordLength int, seed int64) (string, string, error) {
+	profile, err := wordchainsresolver.GetDifficultyProfile(difficulty)
+	if err != nil {
+		return "", "", err
+	}
+	puzzles, err := wcr.NewPuzzleGenerator(seed).Generate(profile, 1, wordLength)
+	if err != nil {
+		return "", "", err
+	}
+	return puzzles[0].From, puzzles[0].To, nil
+}
+
+func play(wcr *wordchainsresolver.WordChainsResolver, game *wordchainsresolver.Game) {
+	target := display(wcr, game.Target())[0]
+	fmt.Println("go from", display(wcr, game.Current())[0], "to", target, "in", game.OptimalSteps(), "step(s) or more")
+	fmt.Println("type one word per turn, :hint to get a hint, :undo to remove your last word, :quit to give up")
+	scanner := bufio.NewScanner(os.Stdin)
+	for !game.IsOver() {
+		fmt.Print(strings.Join(display(wcr, game.Chain()...), " -> "), " -> ... -> ", target, "\n> ")
+		if !scanner.Scan() {
+			fmt.Println()
+			return
+		}
+		input := strings.TrimSpace(scanner.Text())
+		var err error
+		switch input {
+		case "":
+			continue
+		case ":quit":
+			return
+		case ":undo":
+			err = game.Undo()
+		case ":hint":
+			var hints []string
+			hints, err = game.Hint()
+			if err == nil && len(hints) == 0 {
+				fmt.Println("no hint from here, try :undo")
+			} else if err == nil {
+				fmt.Println("try", display(wcr, hints[0])[0])
+			}
+		default:
+			err = game.Play(input)
+		}
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+	fmt.Println("well done :", strings.Join(display(wcr, game.Chain()...), " -> "))
+	fmt.Println("you used", game.Steps(), "step(s) for an optimal of", game.OptimalSteps(), "and", game.Undos(), "undo(s)")
+	fmt.Println("score :", game.Score(), "/ 100")
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	dictionaryOptions.RegisterFrequencyFlags(flag.CommandLine)
+	difficulty := flag.String("difficulty", "easy", "difficulty of a random pair among easy, medium and hard")
+	wordLength := flag.Int("length", 0, "length of the words of a random pair, 0 for any length")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "random seed used to pick a pair")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 && len(args) != 3 {
+		usage(programName)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(wordchainsresolver.NewAStarSolver(), factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	err = dictionaryOptions.ApplyFrequencies(wcr)
+	if err != nil {
+		fmt.Println("error while loading frequency list :", err)
+		return
+	}
+	var word1, word2 string
+	if len(args) == 3 {
+		word1, word2 = args[1], args[2]
+	} else {
+		word1, word2, err = pickPair(wcr, *difficulty, *wordLength, *seed)
+		if err != nil {
+			fmt.Println("error while picking a pair :", err)
+			return
+		}
+	}
+	game, err := wcr.NewGame(word1, word2)
+	if err != nil {
+		fmt.Println("error while starting the game :", err)
+		return
+	}
+	play(wcr, game)
+}
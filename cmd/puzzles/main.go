@@ -0,0 +1,79 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt")
+	fmt.Println("example :\t", programName, "-difficulty hard -length 4 -seed 42 ./assets/app/small_en.txt")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	dictionaryOptions.RegisterFrequencyFlags(flag.CommandLine)
+	seed := flag.Int64("seed", 1, "random seed, the same seed generates the same puzzles")
+	count := flag.Int("count", 10, "number of puzzles to generate")
+	difficulty := flag.String("difficulty", "medium", "difficulty of the puzzles among easy, medium and hard")
+	wordLength := flag.Int("length", 0, "length of the puzzle words, 0 for any length")
+	output := flag.String("output", "", "path to the JSON file to write, standard output if empty")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		usage(programName)
+		return
+	}
+	profile, err := wordchainsresolver.GetDifficultyProfile(*difficulty)
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	err = dictionaryOptions.ApplyFrequencies(wcr)
+	if err != nil {
+		fmt.Println("error while loading frequency list :", err)
+		return
+	}
+	puzzles, err := wcr.NewPuzzleGenerator(*seed).Generate(profile, *count, *wordLength)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "warning :", err, "- generated", len(puzzles), "puzzle(s)")
+	}
+	for index := range puzzles {
+		puzzles[index].Chain = wcr.DisplayChains([][]string{puzzles[index].Chain})[0]
+		puzzles[index].From = puzzles[index].Chain[0]
+		puzzles[index].To = puzzles[index].Chain[len(puzzles[index].Chain)-1]
+	}
+	outputFile := os.Stdout
+	if *output != "" {
+		outputFile, err = os.Create(*output)
+		if err != nil {
+			fmt.Println("error while creating output file :", err)
+			return
+		}
+		defer outputFile.Close()
+	}
+	err = wordchainsresolver.ExportPuzzlesJSON(outputFile, puzzles)
+	if err != nil {
+		fmt.Println("error while exporting puzzles :", err)
+	}
+}
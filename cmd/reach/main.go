@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt word [target ...]")
+	fmt.Println("example :\t", programName, "./assets/app/small_en.txt cat dog cold")
+	fmt.Println("example :\t", programName, "-save cat.json ./assets/app/small_en.txt cat")
+	fmt.Println("example :\t", programName, "-load cat.json ./assets/app/small_en.txt cat dog")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func loadDistanceMap(wcr *wordchainsresolver.WordChainsResolver, path string) (*wordchainsresolver.DistanceMap, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	distanceMap, err := wordchainsresolver.LoadDistanceMap(file)
+	if err != nil {
+		return nil, err
+	}
+	return distanceMap, wcr.CheckDistanceMap(distanceMap)
+}
+
+func saveDistanceMap(distanceMap *wordchainsresolver.DistanceMap, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return distanceMap.Save(file)
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	listCount := flag.Int("list", 10, "maximum number of words printed per distance")
+	load := flag.String("load", "", "path to a distance map saved with -save, instead of walking the graph")
+	save := flag.String("save", "", "path to the file to save the distance map to")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) < 2 {
+		usage(programName)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	word := args[1]
+	if !wcr.IsWordInDB(word) {
+		fmt.Println(word, "is not in your database")
+		return
+	}
+	var distanceMap *wordchainsresolver.DistanceMap
+	if *load != "" {
+		distanceMap, err = loadDistanceMap(wcr, *load)
+		if err == nil && distanceMap.From != wcr.Normalize(word) {
+			err = fmt.Errorf("distance map is from %s", distanceMap.From)
+		}
+	} else {
+		distanceMap, err = wcr.DistanceMapFrom(word)
+	}
+	if err != nil {
+		fmt.Println("error while computing distance map :", err)
+		return
+	}
+	if *save != "" {
+		err = saveDistanceMap(distanceMap, *save)
+		if err != nil {
+			fmt.Println("error while saving distance map :", err)
+			return
+		}
+	}
+
+	targets := args[2:]
+	if len(targets) == 0 {
+		fmt.Println(len(distanceMap.Distances), "word(s) reachable from", word)
+		for distance, layer := range distanceMap.Layers() {
+			displayLayer := wcr.DisplayChains([][]string{layer})[0]
+			if len(displayLayer) > *listCount {
+				displayLayer = append(displayLayer[:*listCount], "...")
+			}
+			fmt.Println("distance", distance, ":", len(layer), "word(s) :", strings.Join(displayLayer, " "))
+		}
+		return
+	}
+	for _, target := range targets {
+		chain := distanceMap.ChainTo(wcr.Normalize(target))
+		if chain == nil {
+			fmt.Println(target, "can not be reached from", word)
+			continue
+		}
+		fmt.Println(target, "is", len(chain)-1, "step(s) away :", strings.Join(wcr.DisplayChains([][]string{chain})[0], " -> "))
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+func usage(programName string) {
+	fmt.Println("usage :\t\t", programName, "[options] path/to/wordlist.txt")
+	fmt.Println("example :\t", programName, "-hubs 3 ./assets/app/small_en.txt")
+	fmt.Println("options :")
+	flag.PrintDefaults()
+}
+
+func printLengthStats(stats wordchainsresolver.LengthStats, isolatedCount int) {
+	fmt.Println("words of", stats.Length, "letter(s) :")
+	fmt.Println("\twords :", stats.Words)
+	fmt.Println("\tedges :", stats.Edges)
+	fmt.Println("\tcomponents :", stats.Components)
+	fmt.Println("\tlargest component :", stats.LargestComponent)
+	fmt.Println("\tisolated words :", len(stats.IsolatedWords))
+	for index, word := range stats.IsolatedWords {
+		if index == isolatedCount {
+			fmt.Println("\t\t...")
+			break
+		}
+		fmt.Println("\t\t" + word)
+	}
+	fmt.Println("\tdegree distribution :")
+	var degrees []int
+	for degree := range stats.DegreeDistribution {
+		degrees = append(degrees, degree)
+	}
+	sort.Ints(degrees)
+	for _, degree := range degrees {
+		fmt.Println("\t\tdegree", degree, ":", stats.DegreeDistribution[degree], "word(s)")
+	}
+	fmt.Println("\thubs :")
+	for _, hub := range stats.Hubs {
+		fmt.Println("\t\t"+hub.Word, ":", hub.Degree, "neighbor(s)")
+	}
+}
+
+func main() {
+	programName := os.Args[0]
+	dictionaryOptions := &wordchainscli.DictionaryOptions{}
+	dictionaryOptions.RegisterFlags(flag.CommandLine)
+	hubCount := flag.Int("hubs", 5, "number of highest degree words to print per word length")
+	isolatedCount := flag.Int("isolated", 10, "maximum number of isolated words to print per word length")
+	flag.Usage = func() { usage(programName) }
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		usage(programName)
+		return
+	}
+	factory, err := dictionaryOptions.NewFactory(args[0])
+	if err != nil {
+		fmt.Println("error while reading options :", err)
+		return
+	}
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, factory)
+	err = wcr.LoadDB()
+	if err != nil {
+		fmt.Println("error while loading word list :", err)
+		return
+	}
+	wordchainscli.PrintFilterReport(os.Stdout, factory)
+	for _, stats := range wcr.Stats(*hubCount) {
+		printLengthStats(stats, *isolatedCount)
+	}
+}
@@ -2,4 +2,7 @@ module github.com/clnbs/wordChains
 
 go 1.14
 
-require github.com/stretchr/testify v1.6.1
+require (
+	github.com/stretchr/testify v1.6.1
+	golang.org/x/text v0.3.3
+)
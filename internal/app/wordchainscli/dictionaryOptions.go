@@ -0,0 +1,126 @@
+package wordchainscli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+// DictionaryOptions gathers command line options describing how
+// a word list file is loaded
+type DictionaryOptions struct {
+	Normalization  string
+	MinLength      int
+	MaxLength      int
+	Alphabet       string
+	IncludePattern string
+	ExcludePattern string
+	Blocklist      string
+	Frequencies    string
+	MinFrequency   int
+}
+
+// RegisterFlags declares dictionary options in a flag set
+func (options *DictionaryOptions) RegisterFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&options.Normalization, "normalize", "lower",
+		"comma separated normalization steps among nfc, nfd, accents and lower[:locale]")
+	flagSet.IntVar(&options.MinLength, "min-length", 0, "remove words shorter than this length")
+	flagSet.IntVar(&options.MaxLength, "max-length", 0, "remove words longer than this length")
+	flagSet.StringVar(&options.Alphabet, "alphabet", "", "remove words using a letter which is not in this alphabet")
+	flagSet.StringVar(&options.IncludePattern, "include", "", "remove words which do not match this regular expression")
+	flagSet.StringVar(&options.ExcludePattern, "exclude", "", "remove words which match this regular expression")
+	flagSet.StringVar(&options.Blocklist, "blocklist", "", "path to a file of words to remove, one word per line")
+}
+
+// RegisterFrequencyFlags declares frequency list options in a flag set. Only
+// commands calling ApplyFrequencies declare them
+func (options *DictionaryOptions) RegisterFrequencyFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&options.Frequencies, "frequencies", "", "path to a word<TAB>count file used to rank solutions by commonness")
+	flagSet.IntVar(&options.MinFrequency, "min-frequency", 0, "do not use intermediate words less frequent than this count")
+}
+
+// NewFactory create the Factory loading the word list file at path
+func (options *DictionaryOptions) NewFactory(path string) (wordchainsresolver.Factory, error) {
+	normalizer, err := wordchainsresolver.ParseNormalizer(options.Normalization)
+	if err != nil {
+		return nil, err
+	}
+	factory := wordchainsresolver.NewNormalizedFactory(wordchainsresolver.NewRawFileLoaderFactory(path), normalizer)
+	rules, err := options.filterRules(normalizer)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return factory, nil
+	}
+	return wordchainsresolver.NewFilteredFactory(factory, rules...), nil
+}
+
+func (options *DictionaryOptions) filterRules(normalizer *wordchainsresolver.Normalizer) ([]wordchainsresolver.FilterRule, error) {
+	var rules []wordchainsresolver.FilterRule
+	if options.MinLength > 0 {
+		rules = append(rules, wordchainsresolver.NewMinLengthRule(options.MinLength))
+	}
+	if options.MaxLength > 0 {
+		rules = append(rules, wordchainsresolver.NewMaxLengthRule(options.MaxLength))
+	}
+	if options.Alphabet != "" {
+		rules = append(rules, wordchainsresolver.NewAlphabetRule(options.Alphabet))
+	}
+	if options.IncludePattern != "" {
+		pattern, err := regexp.Compile(options.IncludePattern)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, wordchainsresolver.NewIncludePatternRule(pattern))
+	}
+	if options.ExcludePattern != "" {
+		pattern, err := regexp.Compile(options.ExcludePattern)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, wordchainsresolver.NewExcludePatternRule(pattern))
+	}
+	if options.Blocklist != "" {
+		blocklistFactory := wordchainsresolver.NewNormalizedFactory(wordchainsresolver.NewRawFileLoaderFactory(options.Blocklist), normalizer)
+		rule, err := wordchainsresolver.NewBlocklistRule(blocklistFactory)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ApplyFrequencies loads the frequency list file, if any, and makes
+// the resolver use it
+func (options *DictionaryOptions) ApplyFrequencies(wcr *wordchainsresolver.WordChainsResolver) error {
+	if options.Frequencies == "" {
+		return nil
+	}
+	normalizer, err := wordchainsresolver.ParseNormalizer(options.Normalization)
+	if err != nil {
+		return err
+	}
+	frequencies, err := wordchainsresolver.LoadFrequencyList(options.Frequencies, normalizer)
+	if err != nil {
+		return err
+	}
+	wcr.UseFrequencies(frequencies, options.MinFrequency)
+	return nil
+}
+
+// PrintFilterReport writes how many words each filtering rule removed,
+// if factory filters words
+func PrintFilterReport(w io.Writer, factory wordchainsresolver.Factory) {
+	filteredFactory, ok := factory.(*wordchainsresolver.FilteredFactory)
+	if !ok {
+		return
+	}
+	for _, report := range filteredFactory.Report() {
+		fmt.Fprintln(w, "rule", report.Rule, "removed", report.Removed, "word(s)")
+	}
+}
@@ -0,0 +1,77 @@
+package wordchainscli
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionaryOptions_NewFactory(t *testing.T) {
+	options := &DictionaryOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-normalize", "nfc,lower"}))
+	assert.Equal(t, "nfc,lower", options.Normalization)
+
+	factory, err := options.NewFactory(os.Getenv("GOPATH") + "/src/github.com/clnbs/wordChains/assets/app/small_en.txt")
+	assert.Nil(t, err)
+	wordList, err := factory.LoadDB()
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(wordList))
+
+	options.Normalization = "unknown"
+	_, err = options.NewFactory("")
+	assert.NotNil(t, err)
+}
+
+func TestDictionaryOptions_filterRules(t *testing.T) {
+	options := &DictionaryOptions{Normalization: "lower"}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-min-length", "3", "-max-length", "3", "-exclude", "^z"}))
+
+	factory, err := options.NewFactory(os.Getenv("GOPATH") + "/src/github.com/clnbs/wordChains/assets/app/small_en.txt")
+	assert.Nil(t, err)
+	_, err = factory.LoadDB()
+	assert.Nil(t, err)
+	buffer := &bytes.Buffer{}
+	PrintFilterReport(buffer, factory)
+	assert.Contains(t, buffer.String(), "rule min-length 3 removed 47 word(s)")
+	assert.Equal(t, 3, strings.Count(buffer.String(), "\n"))
+
+	options.IncludePattern = "("
+	_, err = options.NewFactory("")
+	assert.NotNil(t, err)
+	options.IncludePattern = ""
+	options.Blocklist = "/badpath/thing.txt"
+	_, err = options.NewFactory("")
+	assert.NotNil(t, err)
+}
+
+func TestDictionaryOptions_RegisterFrequencyFlags(t *testing.T) {
+	options := &DictionaryOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Lookup("frequencies"))
+	assert.Nil(t, flagSet.Lookup("min-frequency"))
+	options.RegisterFrequencyFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-frequencies", "freq.txt", "-min-frequency", "10"}))
+	assert.Equal(t, "freq.txt", options.Frequencies)
+	assert.Equal(t, 10, options.MinFrequency)
+}
+
+func TestDictionaryOptions_ApplyFrequencies(t *testing.T) {
+	options := &DictionaryOptions{Normalization: "lower"}
+	wcr := wordchainsresolver.NewWordChainsResolver(wordchainsresolver.NewBFSSolver(), &wordchainsresolver.FileLoaderFactory{})
+	assert.Nil(t, options.ApplyFrequencies(wcr))
+
+	options.Frequencies = "/badpath/thing.txt"
+	assert.NotNil(t, options.ApplyFrequencies(wcr))
+	options.Normalization = "unknown"
+	assert.NotNil(t, options.ApplyFrequencies(wcr))
+}
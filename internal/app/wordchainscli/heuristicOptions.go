@@ -0,0 +1,78 @@
+package wordchainscli
+
+import (
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+// ErrorHeuristicNotSupported is trigger when a heuristic is given to a solver
+// which is not guided by one
+var ErrorHeuristicNotSupported = errors.New("heuristic : solver is not guided by a heuristic")
+
+// ErrorHeuristicWithLandmarks is trigger when both a heuristic name and a
+// landmarks file are given
+var ErrorHeuristicWithLandmarks = errors.New("heuristic : a heuristic name and a landmarks file can not be combined")
+
+// HeuristicOptions gathers command line options choosing the heuristic
+// guiding informed solvers
+type HeuristicOptions struct {
+	Name      string
+	Landmarks string
+}
+
+// RegisterFlags declares heuristic options in a flag set
+func (options *HeuristicOptions) RegisterFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&options.Name, "heuristic", "", "heuristic guiding the search among hamming and alphabet, default is the move rule lower bound")
+	flagSet.StringVar(&options.Landmarks, "landmarks", "", "landmarks file written by the landmarks command, guiding the search with precomputed distances")
+}
+
+// Apply sets the heuristic of the solver if one is asked
+func (options *HeuristicOptions) Apply(solver wordchainsresolver.Solver) error {
+	if options.Name != "" && options.Landmarks != "" {
+		return ErrorHeuristicWithLandmarks
+	}
+	if options.Name == "" {
+		return nil
+	}
+	heuristic, err := wordchainsresolver.ParseHeuristic(options.Name)
+	if err != nil {
+		return err
+	}
+	informedSolver, ok := solver.(wordchainsresolver.InformedSolver)
+	if !ok {
+		return ErrorHeuristicNotSupported
+	}
+	informedSolver.SetHeuristic(heuristic)
+	return nil
+}
+
+// ApplyLandmarks sets the landmarks file heuristic of the solver if one is
+// asked. The word list must be loaded, landmarks computed on another
+// dictionary are refused
+func (options *HeuristicOptions) ApplyLandmarks(wcr *wordchainsresolver.WordChainsResolver, solver wordchainsresolver.Solver) error {
+	if options.Landmarks == "" {
+		return nil
+	}
+	informedSolver, ok := solver.(wordchainsresolver.InformedSolver)
+	if !ok {
+		return ErrorHeuristicNotSupported
+	}
+	file, err := os.Open(options.Landmarks)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	heuristic, err := wordchainsresolver.LoadLandmarkHeuristic(file)
+	if err != nil {
+		return err
+	}
+	err = wcr.CheckLandmarkHeuristic(heuristic)
+	if err != nil {
+		return err
+	}
+	informedSolver.SetHeuristic(heuristic)
+	return nil
+}
@@ -0,0 +1,72 @@
+package wordchainscli
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeuristicOptions(t *testing.T) {
+	options := &HeuristicOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-heuristic", "alphabet"}))
+
+	solver := wordchainsresolver.NewAStarSolver()
+	assert.Nil(t, options.Apply(solver))
+	result, err := solver.FindWordChains("cat", "dog", []string{"cat", "cot", "cog", "dog"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+
+	assert.Equal(t, ErrorHeuristicNotSupported, options.Apply(wordchainsresolver.NewBFSSolver()))
+	options.Name = "euclid"
+	assert.Equal(t, wordchainsresolver.ErrorUnknownHeuristic, options.Apply(solver))
+	options.Name = ""
+	assert.Nil(t, options.Apply(wordchainsresolver.NewBFSSolver()))
+}
+
+func TestHeuristicOptions_Landmarks(t *testing.T) {
+	directory, err := ioutil.TempDir("", "landmarks")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "words.txt.landmarks.json")
+
+	words := []string{"cat", "cot", "cog", "dog"}
+	solver := wordchainsresolver.NewAStarSolver()
+	wcr := wordchainsresolver.NewWordChainsResolver(solver, &MockListFactory{words: words})
+	assert.Nil(t, wcr.LoadDB())
+	file, err := os.Create(path)
+	assert.Nil(t, err)
+	assert.Nil(t, wcr.PrecomputeLandmarks(1, 2).Save(file))
+	assert.Nil(t, file.Close())
+
+	options := &HeuristicOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-landmarks", path}))
+	assert.Nil(t, options.Apply(solver))
+	assert.Nil(t, options.ApplyLandmarks(wcr, solver))
+	result, err := wcr.Solve("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+	assert.Equal(t, ErrorHeuristicNotSupported, options.ApplyLandmarks(wcr, wordchainsresolver.NewBFSSolver()))
+
+	other := wordchainsresolver.NewWordChainsResolver(solver, &MockListFactory{words: words[:3]})
+	assert.Nil(t, other.LoadDB())
+	assert.Equal(t, wordchainsresolver.ErrorLandmarksOutdated, options.ApplyLandmarks(other, solver))
+	_, err = wcr.Solve("cat", "dog", wordchainsresolver.WithMoveGenerator(wordchainsresolver.AnagramMoves{}))
+	assert.Equal(t, wordchainsresolver.ErrorLandmarksWithMoves, err)
+
+	options.Name = "hamming"
+	assert.Equal(t, ErrorHeuristicWithLandmarks, options.Apply(solver))
+	options.Name = ""
+	options.Landmarks = filepath.Join(directory, "missing.json")
+	assert.NotNil(t, options.ApplyLandmarks(wcr, solver))
+	options.Landmarks = ""
+	assert.Nil(t, options.ApplyLandmarks(wcr, wordchainsresolver.NewBFSSolver()))
+}
@@ -0,0 +1,20 @@
+package wordchainscli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PrintSolutions writes word chains in a human readable way
+func PrintSolutions(w io.Writer, solutions [][]string) {
+	if len(solutions) == 0 {
+		fmt.Fprintln(w, "no solution found")
+		return
+	}
+	fmt.Fprintln(w, "found", len(solutions), "solution(s)")
+	for index, chain := range solutions {
+		fmt.Fprint(w, "solution #", index+1, " : ", strings.Join(chain, " -> "))
+		fmt.Fprintln(w)
+	}
+}
@@ -0,0 +1,18 @@
+package wordchainscli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintSolutions(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	PrintSolutions(buffer, [][]string{{"cat", "cot", "cog", "dog"}})
+	assert.Equal(t, "found 1 solution(s)\nsolution #1 : cat -> cot -> cog -> dog\n", buffer.String())
+
+	buffer.Reset()
+	PrintSolutions(buffer, nil)
+	assert.Equal(t, "no solution found\n", buffer.String())
+}
@@ -0,0 +1,103 @@
+package wordchainscli
+
+import (
+	"flag"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+// QueryOptions gathers command line options constraining the searched word chains
+type QueryOptions struct {
+	Via                 string
+	Avoid               string
+	AvoidPattern        string
+	LockedPositions     string
+	ChangeEveryPosition bool
+	BannedLetters       string
+	Moves               string
+}
+
+// RegisterFlags declares query options in a flag set
+func (options *QueryOptions) RegisterFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&options.Via, "via", "", "comma separated words the word chains must pass through, in order")
+	flagSet.StringVar(&options.Avoid, "avoid", "", "comma separated words the word chains must not use")
+	flagSet.StringVar(&options.AvoidPattern, "avoid-pattern", "", "regular expression matching words the word chains must not use")
+	flagSet.StringVar(&options.LockedPositions, "lock", "", "comma separated letter positions, counted from 0, which may never change")
+	flagSet.BoolVar(&options.ChangeEveryPosition, "change-every-position", false, "every letter position must be changed at least once")
+	flagSet.StringVar(&options.BannedLetters, "banned-letters", "", "letters intermediate words may not contain")
+	flagSet.StringVar(&options.Moves, "moves", "", "comma separated move rules among substitution, insert-delete and anagram, default is substitution")
+}
+
+// QueryOptionsFromValues return the query options given by URL query parameters
+// named after the command line options, such as via or banned-letters. Other
+// parameters and empty ones are ignored
+func QueryOptionsFromValues(values url.Values) (*QueryOptions, error) {
+	options := &QueryOptions{}
+	flagSet := flag.NewFlagSet("query", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	for name := range values {
+		if flagSet.Lookup(name) == nil || values.Get(name) == "" {
+			continue
+		}
+		err := flagSet.Set(name, values.Get(name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return options, nil
+}
+
+// QueryOptions return the constraints to give to WordChainsResolver.Solve
+func (options *QueryOptions) QueryOptions() ([]wordchainsresolver.QueryOption, error) {
+	var queryOptions []wordchainsresolver.QueryOption
+	if via := SplitWords(options.Via); len(via) != 0 {
+		queryOptions = append(queryOptions, wordchainsresolver.Via(via...))
+	}
+	if avoid := SplitWords(options.Avoid); len(avoid) != 0 {
+		queryOptions = append(queryOptions, wordchainsresolver.Avoid(avoid...))
+	}
+	if options.AvoidPattern != "" {
+		pattern, err := regexp.Compile(options.AvoidPattern)
+		if err != nil {
+			return nil, err
+		}
+		queryOptions = append(queryOptions, wordchainsresolver.AvoidPattern(pattern))
+	}
+	if options.Moves != "" {
+		moves, err := wordchainsresolver.ParseMoveGenerator(options.Moves)
+		if err != nil {
+			return nil, err
+		}
+		queryOptions = append(queryOptions, wordchainsresolver.WithMoveGenerator(moves))
+	}
+	if options.LockedPositions == "" && !options.ChangeEveryPosition && options.BannedLetters == "" {
+		return queryOptions, nil
+	}
+	constraint := wordchainsresolver.ChainConstraint{
+		ChangeEveryPosition: options.ChangeEveryPosition,
+		BannedLetters:       options.BannedLetters,
+	}
+	for _, position := range SplitWords(options.LockedPositions) {
+		lockedPosition, err := strconv.Atoi(position)
+		if err != nil {
+			return nil, err
+		}
+		constraint.LockedPositions = append(constraint.LockedPositions, lockedPosition)
+	}
+	return append(queryOptions, wordchainsresolver.WithChainConstraint(constraint)), nil
+}
+
+// SplitWords return the words of a comma separated list
+func SplitWords(words string) []string {
+	var wordList []string
+	for _, word := range strings.Split(words, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			wordList = append(wordList, word)
+		}
+	}
+	return wordList
+}
@@ -0,0 +1,99 @@
+package wordchainscli
+
+import (
+	"flag"
+	"net/url"
+	"testing"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockListFactory struct {
+	words []string
+}
+
+func (factory *MockListFactory) LoadDB() ([]string, error) {
+	return factory.words, nil
+}
+
+func TestQueryOptions(t *testing.T) {
+	options := &QueryOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-via", "hut", "-avoid", "cot, dot", "-avoid-pattern", "^h.g$"}))
+	queryOptions, err := options.QueryOptions()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(queryOptions))
+
+	words := []string{"cat", "cot", "cog", "dog", "dot", "cut", "hut", "hot", "hog"}
+	wcr := wordchainsresolver.NewWordChainsResolver(wordchainsresolver.NewBFSSolver(), &MockListFactory{words: words})
+	assert.Nil(t, wcr.LoadDB())
+	result, err := wcr.Solve("cat", "dog", queryOptions...)
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	options.AvoidPattern = "("
+	_, err = options.QueryOptions()
+	assert.NotNil(t, err)
+	queryOptions, err = (&QueryOptions{}).QueryOptions()
+	assert.Nil(t, err)
+	assert.Nil(t, queryOptions)
+}
+
+func TestQueryOptions_ChainConstraint(t *testing.T) {
+	options := &QueryOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-lock", "2", "-banned-letters", "o"}))
+	queryOptions, err := options.QueryOptions()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queryOptions))
+
+	words := []string{"cat", "cot", "cut", "hut", "hat", "hot"}
+	wcr := wordchainsresolver.NewWordChainsResolver(wordchainsresolver.NewBFSSolver(), &MockListFactory{words: words})
+	assert.Nil(t, wcr.LoadDB())
+	result, err := wcr.Solve("cat", "hot", queryOptions...)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "hat", "hot"}}, result)
+
+	options.LockedPositions = "first"
+	_, err = options.QueryOptions()
+	assert.NotNil(t, err)
+}
+
+func TestQueryOptions_Moves(t *testing.T) {
+	options := &QueryOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-moves", "insert-delete"}))
+	queryOptions, err := options.QueryOptions()
+	assert.Nil(t, err)
+
+	words := []string{"cat", "cant", "can", "cane"}
+	wcr := wordchainsresolver.NewWordChainsResolver(wordchainsresolver.NewBFSSolver(), &MockListFactory{words: words})
+	assert.Nil(t, wcr.LoadDB())
+	result, err := wcr.Solve("cat", "cane", queryOptions...)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cant", "can", "cane"}}, result)
+
+	options.Moves = "swap"
+	_, err = options.QueryOptions()
+	assert.Equal(t, wordchainsresolver.ErrorUnknownMoveGenerator, err)
+}
+
+func TestQueryOptionsFromValues(t *testing.T) {
+	values, err := url.ParseQuery("solver=bfs&via=hut&avoid=cot,dot&lock=2&change-every-position=true&banned-letters=&moves=anagram")
+	assert.Nil(t, err)
+	options, err := QueryOptionsFromValues(values)
+	assert.Nil(t, err)
+	assert.Equal(t, &QueryOptions{Via: "hut", Avoid: "cot,dot", LockedPositions: "2", ChangeEveryPosition: true, Moves: "anagram"}, options)
+
+	_, err = QueryOptionsFromValues(url.Values{"change-every-position": {"maybe"}})
+	assert.NotNil(t, err)
+}
+
+func TestSplitWords(t *testing.T) {
+	assert.Equal(t, []string{"cat", "dog"}, SplitWords(" cat,, dog "))
+	assert.Nil(t, SplitWords(""))
+}
@@ -0,0 +1,66 @@
+package wordchainscli
+
+import (
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+// ErrorSearchTreeNotSupported is trigger when a search tree is asked to
+// a solver which does not record it
+var ErrorSearchTreeNotSupported = errors.New("search tree : solver does not record its search tree")
+
+// SearchTreeOptions gathers command line options describing how the tree
+// explored by a solver is written
+type SearchTreeOptions struct {
+	Format string
+	Output string
+}
+
+// RegisterFlags declares search tree options in a flag set
+func (options *SearchTreeOptions) RegisterFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&options.Format, "tree", "", "write the explored search tree in this format among dot and ascii")
+	flagSet.StringVar(&options.Output, "tree-output", "", "path to the search tree file, standard output if empty")
+}
+
+// Record makes the solver record its search tree if a tree format is asked
+func (options *SearchTreeOptions) Record(solver wordchainsresolver.Solver) error {
+	if options.Format == "" {
+		return nil
+	}
+	if _, err := wordchainsresolver.ParseTreeFormat(options.Format); err != nil {
+		return err
+	}
+	recorder, ok := solver.(wordchainsresolver.SearchTreeRecorder)
+	if !ok {
+		return ErrorSearchTreeNotSupported
+	}
+	recorder.RecordSearchTree(true)
+	return nil
+}
+
+// Write writes the search tree recorded by the solver, if a tree format is asked
+func (options *SearchTreeOptions) Write(solver wordchainsresolver.Solver) error {
+	if options.Format == "" {
+		return nil
+	}
+	format, err := wordchainsresolver.ParseTreeFormat(options.Format)
+	if err != nil {
+		return err
+	}
+	recorder, ok := solver.(wordchainsresolver.SearchTreeRecorder)
+	if !ok {
+		return ErrorSearchTreeNotSupported
+	}
+	outputFile := os.Stdout
+	if options.Output != "" {
+		outputFile, err = os.Create(options.Output)
+		if err != nil {
+			return err
+		}
+		defer outputFile.Close()
+	}
+	return wordchainsresolver.WriteSearchTree(outputFile, recorder.SearchTree(), format)
+}
@@ -0,0 +1,38 @@
+package wordchainscli
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTreeOptions(t *testing.T) {
+	options := &SearchTreeOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	directory, err := ioutil.TempDir("", "wordchains")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+	output := filepath.Join(directory, "tree.txt")
+	assert.Nil(t, flagSet.Parse([]string{"-tree", "ascii", "-tree-output", output}))
+
+	solver := wordchainsresolver.NewBFSSolver()
+	assert.Nil(t, options.Record(solver))
+	_, err = solver.FindWordChains("cat", "cot", []string{"cat", "cot", "cut"})
+	assert.Nil(t, err)
+	assert.Nil(t, options.Write(solver))
+	content, err := ioutil.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Equal(t, "cat *\n├── cot *\n└── cut\n    └── cot\n", string(content))
+
+	assert.Equal(t, ErrorSearchTreeNotSupported, options.Record(wordchainsresolver.NewAStarSolver()))
+	options.Format = "svg"
+	assert.Equal(t, wordchainsresolver.ErrorUnknownTreeFormat, options.Record(solver))
+	options.Format = ""
+	assert.Nil(t, options.Record(wordchainsresolver.NewAStarSolver()))
+}
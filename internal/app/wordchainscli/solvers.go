@@ -0,0 +1,28 @@
+package wordchainscli
+
+import (
+	"errors"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+// ErrorUnknownSolver is trigger when a solver name is unknown
+var ErrorUnknownSolver = errors.New("solver : unknown solver")
+
+// SolverNames are the names accepted by NewSolver
+var SolverNames = []string{"astar", "beam", "bfs", "greedy"}
+
+// NewSolver create a solver with its default options from its name
+func NewSolver(name string) (wordchainsresolver.Solver, error) {
+	switch name {
+	case "astar":
+		return wordchainsresolver.NewAStarSolver(), nil
+	case "beam":
+		return wordchainsresolver.NewBeamSolver(10), nil
+	case "bfs":
+		return wordchainsresolver.NewBFSSolver(), nil
+	case "greedy":
+		return wordchainsresolver.NewGreedySolver(), nil
+	}
+	return nil, ErrorUnknownSolver
+}
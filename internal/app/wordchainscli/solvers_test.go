@@ -0,0 +1,19 @@
+package wordchainscli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSolver(t *testing.T) {
+	for _, name := range SolverNames {
+		solver, err := NewSolver(name)
+		assert.Nil(t, err, name)
+		result, err := solver.FindWordChains("cat", "cot", []string{"cat", "cot"})
+		assert.Nil(t, err, name)
+		assert.Equal(t, [][]string{{"cat", "cot"}}, result, name)
+	}
+	_, err := NewSolver("dijkstra")
+	assert.Equal(t, ErrorUnknownSolver, err)
+}
@@ -0,0 +1,81 @@
+package wordchainscli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+// ErrorObserverNotSupported is trigger when search events are asked to
+// a solver which does not send them
+var ErrorObserverNotSupported = errors.New("search events : solver does not send search events")
+
+// TraceOptions gathers command line options describing how search events are observed
+type TraceOptions struct {
+	Log   bool
+	Count bool
+	Trace string
+
+	counter *wordchainsresolver.CounterObserver
+	tracer  *wordchainsresolver.TraceObserver
+}
+
+// RegisterFlags declares search event options in a flag set
+func (options *TraceOptions) RegisterFlags(flagSet *flag.FlagSet) {
+	flagSet.BoolVar(&options.Log, "log-events", false, "print every search event")
+	flagSet.BoolVar(&options.Count, "count-events", false, "print the number of search events of each kind")
+	flagSet.StringVar(&options.Trace, "trace", "", "path to a Chrome trace event JSON file to write")
+}
+
+// Observe sets the observers asked by the options on the solver, log
+// lines are written to w
+func (options *TraceOptions) Observe(solver wordchainsresolver.Solver, w io.Writer) error {
+	var observers wordchainsresolver.MultiObserver
+	if options.Log {
+		observers = append(observers, wordchainsresolver.NewLogObserver(w))
+	}
+	if options.Count {
+		options.counter = wordchainsresolver.NewCounterObserver()
+		observers = append(observers, options.counter)
+	}
+	if options.Trace != "" {
+		options.tracer = wordchainsresolver.NewTraceObserver()
+		observers = append(observers, options.tracer)
+	}
+	if len(observers) == 0 {
+		return nil
+	}
+	observableSolver, ok := solver.(wordchainsresolver.ObservableSolver)
+	if !ok {
+		return ErrorObserverNotSupported
+	}
+	observableSolver.SetObserver(observers)
+	return nil
+}
+
+// Report prints event counts to w and writes the trace file, if asked
+func (options *TraceOptions) Report(w io.Writer) error {
+	if options.counter != nil {
+		for _, kind := range []wordchainsresolver.SearchEventKind{
+			wordchainsresolver.NodeGenerated,
+			wordchainsresolver.NodeExpanded,
+			wordchainsresolver.NodePruned,
+			wordchainsresolver.SolutionFound,
+		} {
+			fmt.Fprintln(w, kind, ":", options.counter.Count(kind))
+		}
+	}
+	if options.tracer == nil {
+		return nil
+	}
+	traceFile, err := os.Create(options.Trace)
+	if err != nil {
+		return err
+	}
+	defer traceFile.Close()
+	return options.tracer.Export(traceFile)
+}
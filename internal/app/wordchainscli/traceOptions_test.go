@@ -0,0 +1,43 @@
+package wordchainscli
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceOptions(t *testing.T) {
+	directory, err := ioutil.TempDir("", "wordchains")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+	tracePath := filepath.Join(directory, "trace.json")
+
+	options := &TraceOptions{}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	options.RegisterFlags(flagSet)
+	assert.Nil(t, flagSet.Parse([]string{"-log-events", "-count-events", "-trace", tracePath}))
+
+	buffer := &bytes.Buffer{}
+	solver := wordchainsresolver.NewAStarSolver()
+	assert.Nil(t, options.Observe(solver, buffer))
+	_, err = solver.FindWordChains("cat", "cot", []string{"cat", "cot"})
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(buffer.String(), "[astar] started cat at depth 0\n"))
+
+	buffer.Reset()
+	assert.Nil(t, options.Report(buffer))
+	assert.Equal(t, "generated : 1\nexpanded : 1\npruned : 0\nsolution : 1\n", buffer.String())
+	content, err := ioutil.ReadFile(tracePath)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(content), `{"traceEvents":[`))
+
+	assert.Nil(t, (&TraceOptions{}).Observe(nil, buffer))
+	assert.Equal(t, ErrorObserverNotSupported, (&TraceOptions{Log: true}).Observe(nil, buffer))
+}
@@ -1,5 +1,7 @@
 package wordchainsresolver
 
+import "context"
+
 // AStarNode struct represents words tidy in a tree node
 type AStarNode struct {
 	word     string
@@ -44,10 +46,16 @@ type AStarSolver struct {
 	nodeGScore  map[*AStarNode]int
 	nodeFScore  map[*AStarNode]int
 	openSet     map[*AStarNode]interface{}
+	bestDepths  map[string]int
 	wordList    []string
 	usefulWords []string
 	from        string
 	to          string
+	observer    SearchObserver
+	constraint  *ChainConstraint
+	moves       MoveGenerator
+	heuristic   Heuristic
+	ctx         context.Context
 }
 
 // NewAStarSolver is a simple AStarSolver constructor
@@ -57,16 +65,25 @@ func NewAStarSolver() *AStarSolver {
 		nodeGScore:  make(map[*AStarNode]int),
 		nodeFScore:  make(map[*AStarNode]int),
 		openSet:     make(map[*AStarNode]interface{}),
+		bestDepths:  make(map[string]int),
 		wordList:    nil,
 		usefulWords: nil,
 	}
 }
 
+// NewAStarSolverWithHeuristic is an AStarSolver constructor guided by a custom
+// Heuristic. A* stays optimal as long as the Heuristic never overestimates
+func NewAStarSolverWithHeuristic(heuristic Heuristic) *AStarSolver {
+	a := NewAStarSolver()
+	a.heuristic = heuristic
+	return a
+}
+
 // FindWordChains implements the Solver interface. The A* solver generate word chains
 // by looking for the best solutions in a tree. It is a complete algorithm :
 // if there is a solution, A* will find it
 func (a *AStarSolver) FindWordChains(from string, to string, wordList []string) ([][]string, error) {
-	if len(from) != len(to) {
+	if preservesLength(a.moves) && len([]rune(from)) != len([]rune(to)) {
 		return nil, ErrorWordLengthDoesNotMatch
 	}
 	defer a.Clean()
@@ -79,17 +96,30 @@ func (a *AStarSolver) FindWordChains(from string, to string, wordList []string)
 	a.getUsefulWordsOnly()
 
 	a.openSet[head] = nil
+	a.bestDepths[from] = head.Depth()
 	a.nodeGScore[head] = head.Depth()
 	a.nodeFScore[head] = a.getScoreFromGoal(head)
+	a.notify(SearchStarted, head)
+	defer notify(a.observer, SearchEvent{Kind: SearchFinished, Solver: "astar", Word: to})
 
 	// A* main loop
 	for len(a.openSet) != 0 {
+		if err := contextError(a.ctx); err != nil {
+			return nil, err
+		}
 		// can be improved with priorityQueue
 		current := a.getCurrentBestNode()
+		if current.word == goal.word && !a.constraint.changesEveryPosition(current.GetSolution()) {
+			delete(a.openSet, current)
+			a.notify(NodePruned, current)
+			continue
+		}
 		if current.word == goal.word {
+			a.notify(SolutionFound, current)
 			return [][]string{current.GetSolution()}, nil
 		}
 		delete(a.openSet, current)
+		a.notify(NodeExpanded, current)
 
 		neighbors := a.createNeighbors(current)
 		for _, neighbor := range neighbors {
@@ -100,6 +130,7 @@ func (a *AStarSolver) FindWordChains(from string, to string, wordList []string)
 			if _, ok := a.openSet[neighbor]; !ok {
 				a.openSet[neighbor] = nil
 			}
+			a.notify(NodeGenerated, neighbor)
 		}
 	}
 	return nil, nil
@@ -120,25 +151,107 @@ func (a *AStarSolver) getCurrentBestNode() *AStarNode {
 
 func (a *AStarSolver) createNeighbors(node *AStarNode) []*AStarNode {
 	var neighbor []*AStarNode
+	var chain []string
+	if a.constraint != nil && a.constraint.ChangeEveryPosition {
+		// whether a chain changes every position depends on all its words, so
+		// these chains are searched without repeating words, like BFS does
+		chain = node.GetSolution()
+	}
+	depth := node.Depth() + 1
 	for _, nextWord := range a.usefulWords {
-		if isPossibleNextWord(nextWord, node.word) {
-			neighbor = append(neighbor, NewAStarNode(nextWord, node))
+		if !allowsMove(a.moves, a.constraint, node.word, nextWord, a.to) || isWordInList(nextWord, chain) {
+			continue
+		}
+		if chain == nil {
+			// a word already reached with as few moves is not searched again
+			if bestDepth, ok := a.bestDepths[nextWord]; ok && bestDepth <= depth {
+				continue
+			}
+			a.bestDepths[nextWord] = depth
 		}
+		neighbor = append(neighbor, NewAStarNode(nextWord, node))
 	}
 	return neighbor
 }
 
 func (a *AStarSolver) getUsefulWordsOnly() {
-	wordLength := len(a.from)
-	for _, word := range a.wordList {
-		if len(word) == wordLength && word != a.from {
-			a.usefulWords = append(a.usefulWords, word)
-		}
-	}
+	a.usefulWords = candidateWords(a.moves, a.from, a.wordList)
 }
 
 func (a *AStarSolver) getScoreFromGoal(node *AStarNode) int {
-	return len(a.to) - getScoreBetweenTwoWord(node.word, a.to)
+	if a.heuristic == nil {
+		return minMoves(a.moves, node.word, a.to)
+	}
+	return a.heuristic.Estimate(node.word, a.to)
+}
+
+// SetObserver implements the ObservableSolver interface
+func (a *AStarSolver) SetObserver(observer SearchObserver) {
+	a.observer = observer
+}
+
+// SetChainConstraint implements the ConstrainedSolver interface
+func (a *AStarSolver) SetChainConstraint(constraint *ChainConstraint) {
+	a.constraint = constraint
+}
+
+// ChainConstraint implements the ConstrainedSolver interface
+func (a *AStarSolver) ChainConstraint() *ChainConstraint {
+	return a.constraint
+}
+
+// SetHeuristic implements the InformedSolver interface
+func (a *AStarSolver) SetHeuristic(heuristic Heuristic) {
+	a.heuristic = heuristic
+}
+
+// Heuristic implements the InformedSolver interface
+func (a *AStarSolver) Heuristic() Heuristic {
+	return a.heuristic
+}
+
+// SetMoveGenerator implements the MoveGeneratingSolver interface
+func (a *AStarSolver) SetMoveGenerator(moves MoveGenerator) {
+	a.moves = moves
+}
+
+// MoveGenerator implements the MoveGeneratingSolver interface
+func (a *AStarSolver) MoveGenerator() MoveGenerator {
+	return a.moves
+}
+
+// SetContext implements the CancellableSolver interface
+func (a *AStarSolver) SetContext(ctx context.Context) {
+	a.ctx = ctx
+}
+
+// Context implements the CancellableSolver interface
+func (a *AStarSolver) Context() context.Context {
+	return a.ctx
+}
+
+// IsSymmetric implements the SymmetricSolver interface. A* gives a shortest word
+// chain as long as its moves are symmetric and its heuristic never overestimates
+func (a *AStarSolver) IsSymmetric() bool {
+	switch a.heuristic.(type) {
+	case nil, HammingHeuristic, *LandmarkHeuristic:
+		return symmetricMoves(a.moves)
+	}
+	return false
+}
+
+func (a *AStarSolver) notify(kind SearchEventKind, node *AStarNode) {
+	if a.observer == nil {
+		return
+	}
+	event := SearchEvent{Kind: kind, Solver: "astar", Word: node.word, Depth: node.Depth() - 1}
+	if node.previous != nil {
+		event.Parent = node.previous.word
+	}
+	if kind == SolutionFound {
+		event.Chain = node.GetSolution()
+	}
+	notify(a.observer, event)
 }
 
 // Clean delete all data stored in the current AStarSolver instance
@@ -147,6 +260,7 @@ func (a *AStarSolver) Clean() {
 	a.nodeGScore = make(map[*AStarNode]int)
 	a.nodeFScore = make(map[*AStarNode]int)
 	a.openSet = make(map[*AStarNode]interface{})
+	a.bestDepths = make(map[string]int)
 	a.wordList = []string{}
 	a.usefulWords = []string{}
 }
@@ -70,6 +70,23 @@ func TestAStarSolver_FindWordChains(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestAStarSolver_UnreachableGoal(t *testing.T) {
+	words := []string{"cat", "act", "tac", "dog", "god"}
+	solver := NewAStarSolver()
+	solver.SetMoveGenerator(AnagramMoves{})
+	result, err := solver.FindWordChains("cat", "dog", words)
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	words = []string{"cat", "cot", "cut", "hut", "hot", "dog"}
+	solver = NewAStarSolver()
+	solver.SetChainConstraint(&ChainConstraint{BannedLetters: "u"})
+	result, err = solver.FindWordChains("cat", "dog", words)
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	assert.Empty(t, solver.bestDepths)
+}
+
 func TestAStarSolver_GetCurrentBestNode(t *testing.T) {
 	aStar := NewAStarSolver()
 	aStar.nodeFScore = make(map[*AStarNode]int)
@@ -0,0 +1,250 @@
+package wordchainsresolver
+
+import (
+	"context"
+	"sort"
+)
+
+// BeamNode struct represents words tidy in a tree node
+type BeamNode struct {
+	word     string
+	estimate int
+	previous *BeamNode
+}
+
+// NewBeamNode is the BeamNode constructor
+func NewBeamNode(word string, estimate int, previous *BeamNode) *BeamNode {
+	return &BeamNode{
+		word:     word,
+		estimate: estimate,
+		previous: previous,
+	}
+}
+
+// GetSolution return the word chain from the current node
+// by looking at its parent node until it reach the root node
+func (node *BeamNode) GetSolution() []string {
+	var wordChains []string
+	tmpNode := node
+	for tmpNode != nil {
+		wordChains = append(wordChains, tmpNode.word)
+		tmpNode = tmpNode.previous
+	}
+	return flipStringSlice(wordChains)
+}
+
+// Depth return the number of steps from the root node
+func (node *BeamNode) Depth() int {
+	depth := 0
+	for tmpNode := node.previous; tmpNode != nil; tmpNode = tmpNode.previous {
+		depth++
+	}
+	return depth
+}
+
+// BeamSolver is a implementation of Solver interface in order to find
+// word chains with a beam search algorithm. At each depth, only the best
+// nodes according to a Heuristic are kept
+type BeamSolver struct {
+	width       int
+	heuristic   Heuristic
+	wordList    []string
+	usefulWords []string
+	from        string
+	to          string
+	visited     map[string]interface{}
+	observer    SearchObserver
+	constraint  *ChainConstraint
+	moves       MoveGenerator
+	ctx         context.Context
+}
+
+// NewBeamSolver is a BeamSolver constructor scoring words with the lower
+// bound of the MoveGenerator, which is the same scoring function as the
+// greedy solver. A zero width keeps every node, which makes the search a
+// breadth first search
+func NewBeamSolver(width int) *BeamSolver {
+	return NewBeamSolverWithHeuristic(width, nil)
+}
+
+// NewBeamSolverWithHeuristic is a BeamSolver constructor with a custom Heuristic.
+// A nil Heuristic scores words with the lower bound of the MoveGenerator
+func NewBeamSolverWithHeuristic(width int, heuristic Heuristic) *BeamSolver {
+	return &BeamSolver{
+		width:     width,
+		heuristic: heuristic,
+		visited:   make(map[string]interface{}),
+	}
+}
+
+// FindWordChains implements the Solver interface. The beam solver explores the tree
+// depth by depth, like BFS, but only keeps the width best nodes at each depth.
+// The wider the beam is, the more likely the solutions are optimal, but the slower
+// the search is. It is not complete so it may not give any solution
+func (beam *BeamSolver) FindWordChains(from string, to string, wordList []string) ([][]string, error) {
+	if preservesLength(beam.moves) && len([]rune(from)) != len([]rune(to)) {
+		return nil, ErrorWordLengthDoesNotMatch
+	}
+	defer beam.Clean()
+	beam.from = from
+	beam.to = to
+	beam.wordList = wordList
+	beam.getUsefulWordsOnly()
+
+	head := NewBeamNode(from, beam.estimate(from), nil)
+	beam.notify(SearchStarted, head)
+	defer notify(beam.observer, SearchEvent{Kind: SearchFinished, Solver: "beam", Word: to})
+	if from == to {
+		if !beam.constraint.changesEveryPosition(head.GetSolution()) {
+			return nil, nil
+		}
+		beam.notify(SolutionFound, head)
+		return [][]string{head.GetSolution()}, nil
+	}
+	beam.visited[from] = nil
+	nodes := []*BeamNode{head}
+	for len(nodes) != 0 {
+		if err := contextError(beam.ctx); err != nil {
+			return nil, err
+		}
+		children := beam.createChildren(nodes)
+		var solutions [][]string
+		for _, child := range children {
+			if child.word == to {
+				solutions = append(solutions, child.GetSolution())
+				beam.notify(SolutionFound, child)
+			}
+		}
+		if len(solutions) != 0 {
+			return solutions, nil
+		}
+		nodes = beam.selectBestNodes(children)
+	}
+	return nil, nil
+}
+
+func (beam *BeamSolver) createChildren(nodes []*BeamNode) []*BeamNode {
+	var children []*BeamNode
+	for _, node := range nodes {
+		beam.notify(NodeExpanded, node)
+		for _, nextWord := range beam.usefulWords {
+			if _, ok := beam.visited[nextWord]; ok {
+				continue
+			}
+			if allowsMove(beam.moves, beam.constraint, node.word, nextWord, beam.to) {
+				child := NewBeamNode(nextWord, beam.estimate(nextWord), node)
+				if nextWord == beam.to && !beam.constraint.changesEveryPosition(child.GetSolution()) {
+					beam.notify(NodePruned, child)
+					continue
+				}
+				children = append(children, child)
+				beam.notify(NodeGenerated, child)
+			}
+		}
+	}
+	return children
+}
+
+func (beam *BeamSolver) estimate(word string) int {
+	if beam.heuristic == nil {
+		return minMoves(beam.moves, word, beam.to)
+	}
+	return beam.heuristic.Estimate(word, beam.to)
+}
+
+// selectBestNodes keeps the nodes of the width best words. A word reached
+// from several parents keeps all its nodes, each of them may lead to an
+// optimal solution. Kept words are visited, deeper nodes never use them again
+func (beam *BeamSolver) selectBestNodes(nodes []*BeamNode) []*BeamNode {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].estimate < nodes[j].estimate
+	})
+	keptWords := make(map[string]interface{})
+	var bestNodes []*BeamNode
+	for _, node := range nodes {
+		if _, ok := keptWords[node.word]; !ok {
+			if beam.width > 0 && len(keptWords) == beam.width {
+				beam.notify(NodePruned, node)
+				continue
+			}
+			keptWords[node.word] = nil
+		}
+		bestNodes = append(bestNodes, node)
+	}
+	for word := range keptWords {
+		beam.visited[word] = nil
+	}
+	return bestNodes
+}
+
+// SetObserver implements the ObservableSolver interface
+func (beam *BeamSolver) SetObserver(observer SearchObserver) {
+	beam.observer = observer
+}
+
+// SetHeuristic implements the InformedSolver interface
+func (beam *BeamSolver) SetHeuristic(heuristic Heuristic) {
+	beam.heuristic = heuristic
+}
+
+// Heuristic implements the InformedSolver interface
+func (beam *BeamSolver) Heuristic() Heuristic {
+	return beam.heuristic
+}
+
+// SetMoveGenerator implements the MoveGeneratingSolver interface
+func (beam *BeamSolver) SetMoveGenerator(moves MoveGenerator) {
+	beam.moves = moves
+}
+
+// MoveGenerator implements the MoveGeneratingSolver interface
+func (beam *BeamSolver) MoveGenerator() MoveGenerator {
+	return beam.moves
+}
+
+// SetContext implements the CancellableSolver interface
+func (beam *BeamSolver) SetContext(ctx context.Context) {
+	beam.ctx = ctx
+}
+
+// Context implements the CancellableSolver interface
+func (beam *BeamSolver) Context() context.Context {
+	return beam.ctx
+}
+
+// SetChainConstraint implements the ConstrainedSolver interface
+func (beam *BeamSolver) SetChainConstraint(constraint *ChainConstraint) {
+	beam.constraint = constraint
+}
+
+// ChainConstraint implements the ConstrainedSolver interface
+func (beam *BeamSolver) ChainConstraint() *ChainConstraint {
+	return beam.constraint
+}
+
+func (beam *BeamSolver) notify(kind SearchEventKind, node *BeamNode) {
+	if beam.observer == nil {
+		return
+	}
+	event := SearchEvent{Kind: kind, Solver: "beam", Word: node.word, Depth: node.Depth()}
+	if node.previous != nil {
+		event.Parent = node.previous.word
+	}
+	if kind == SolutionFound {
+		event.Chain = node.GetSolution()
+	}
+	notify(beam.observer, event)
+}
+
+func (beam *BeamSolver) getUsefulWordsOnly() {
+	beam.usefulWords = candidateWords(beam.moves, beam.from, beam.wordList)
+}
+
+// Clean delete all data stored in the current BeamSolver instance
+func (beam *BeamSolver) Clean() {
+	beam.wordList = nil
+	beam.usefulWords = nil
+	beam.from = ""
+	beam.to = ""
+	beam.visited = make(map[string]interface{})
+}
@@ -0,0 +1,66 @@
+package wordchainsresolver
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockWordsList_BeamSolver = []string{"cat", "cot", "cog", "dog", "dot", "cut", "hut", "hit", "dummy"}
+
+func TestBeamSolver_FindWordChains(t *testing.T) {
+	solver := NewBeamSolver(10)
+	factory := NewFileLoaderFactory(os.Getenv("GOPATH") + "/src/github.com/clnbs/wordChains/assets/app/small_en.txt")
+	GeneralWordChainsResolverTest(solver, factory, t)
+	_, err := solver.FindWordChains("dummy", "to", []string{})
+	assert.NotNil(t, err)
+}
+
+func TestBeamSolver_Width(t *testing.T) {
+	solver := NewBeamSolver(1)
+	result, err := solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+
+	solver = NewBeamSolver(0)
+	result, err = solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}, {"cat", "cot", "dot", "dog"}}, result)
+
+	result, err = solver.FindWordChains("cat", "cat", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat"}}, result)
+}
+
+func TestBeamSolver_Heuristic(t *testing.T) {
+	// a heuristic preferring words far from the goal sends a narrow beam astray
+	farthestFirst := HeuristicFunc(func(word, goal string) int {
+		return getScoreBetweenTwoWord(word, goal)
+	})
+	solver := NewBeamSolverWithHeuristic(1, farthestFirst)
+	result, err := solver.FindWordChains("cat", "dot", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	solver = NewBeamSolverWithHeuristic(1, HammingHeuristic{})
+	result, err = solver.FindWordChains("cat", "dot", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "dot"}}, result)
+}
+
+func TestBeamSolver_ZeroWidthIsBFS(t *testing.T) {
+	wordList := []string{"cat", "cot", "hat", "hot", "hog", "dog", "cog", "hit", "dot"}
+	for _, from := range wordList {
+		for _, to := range wordList {
+			expected, err := NewBFSSolver().FindWordChains(from, to, wordList)
+			assert.Nil(t, err)
+			result, err := NewBeamSolver(0).FindWordChains(from, to, wordList)
+			assert.Nil(t, err)
+			assert.ElementsMatch(t, expected, result, "%s -> %s", from, to)
+		}
+	}
+	result, err := NewBeamSolver(0).FindWordChains("cat", "hog", wordList)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(result))
+}
@@ -1,5 +1,7 @@
 package wordchainsresolver
 
+import "context"
+
 // BFSWordTreeNode struct represents words tidy in a tree node
 type BFSWordTreeNode struct {
 	Word            string
@@ -43,6 +45,15 @@ func (node *BFSWordTreeNode) GetSolution() []string {
 	return flipStringSlice(wordChains)
 }
 
+func (node *BFSWordTreeNode) searchTree(onSolution map[*BFSWordTreeNode]interface{}) *SearchTreeNode {
+	_, ok := onSolution[node]
+	treeNode := &SearchTreeNode{Word: node.Word, OnSolution: ok}
+	for _, child := range node.NextElements {
+		treeNode.Children = append(treeNode.Children, child.searchTree(onSolution))
+	}
+	return treeNode
+}
+
 // BFSQueue is a BFSWordTreeNode FIFO queue
 type BFSQueue struct {
 	words []*BFSWordTreeNode
@@ -80,6 +91,12 @@ type BFSSolver struct {
 	solutions         []*BFSWordTreeNode
 	bestSolutionDepth int
 	discovered        map[*BFSWordTreeNode]interface{}
+	recordTree        bool
+	observer          SearchObserver
+	constraint        *ChainConstraint
+	moves             MoveGenerator
+	ctx               context.Context
+	searchTree        *SearchTreeNode
 }
 
 // NewBFSSolver is a simple BFSSolver constructor
@@ -112,22 +129,32 @@ func NewBFSSolverWithParams(from, to string, wordList []string) *BFSSolver {
 // by looking for the best solutions in a tree, breadth first. It is a complete algorithm :
 // if there is a solution, BFS will find it
 func (bfs *BFSSolver) FindWordChains(from string, to string, wordList []string) ([][]string, error) {
-	if len(from) != len(to) {
+	if preservesLength(bfs.moves) && len([]rune(from)) != len([]rune(to)) {
 		return nil, ErrorWordLengthDoesNotMatch
 	}
+	bfs.searchTree = nil
 	bfs.from = from
 	bfs.to = to
 	bfs.wordsList = wordList
 
 	bfs.getUsefulWordOnly()
 	bfs.wordTree = NewBFSWordTreeNode(from, nil)
+	bfs.notify(SearchStarted, bfs.wordTree)
 	bfs.solveBFS()
+	notify(bfs.observer, SearchEvent{Kind: SearchFinished, Solver: "bfs", Word: to})
+	if err := contextError(bfs.ctx); err != nil {
+		bfs.Clean()
+		return nil, err
+	}
 
 	var solutions [][]string
 	for _, node := range bfs.solutions {
 		solutions = append(solutions, node.GetSolution())
 	}
 	solutions = getBestSolution(solutions)
+	if bfs.recordTree {
+		bfs.searchTree = bfs.buildSearchTree()
+	}
 	bfs.Clean()
 	return solutions, nil
 }
@@ -137,19 +164,27 @@ func (bfs *BFSSolver) solveBFS() {
 	bfs.discovered[bfs.wordTree] = nil
 	bfs.queue.Add(bfs.wordTree)
 
-	for bfs.queue.Len() != 0 {
+	for bfs.queue.Len() != 0 && contextError(bfs.ctx) == nil {
 		node := bfs.queue.Pop()
+		if node.Word == bfs.to && !bfs.constraint.changesEveryPosition(node.GetSolution()) {
+			// the last word can not be used twice, this chain is a dead end
+			bfs.notify(NodePruned, node)
+			continue
+		}
 		if node.Word == bfs.to {
 			nodeDepth := node.Depth()
 			if nodeDepth <= bfs.bestSolutionDepth {
 				bfs.bestSolutionDepth = nodeDepth
 				bfs.solutions = append(bfs.solutions, node)
+				bfs.notify(SolutionFound, node)
 				continue
 			}
 			if nodeDepth > bfs.bestSolutionDepth {
+				bfs.notify(NodePruned, node)
 				return
 			}
 		}
+		bfs.notify(NodeExpanded, node)
 
 		possibleWords := bfs.listPossibleNextWords(node.Word)
 		alreadyRegisteredWords := node.GetSolution()
@@ -159,24 +194,99 @@ func (bfs *BFSSolver) solveBFS() {
 			if _, ok := bfs.discovered[newNode]; !ok {
 				bfs.discovered[newNode] = nil
 				bfs.queue.Add(newNode)
+				bfs.notify(NodeGenerated, newNode)
 			}
 		}
 	}
 }
 
-func (bfs *BFSSolver) getUsefulWordOnly() {
-	wordLength := len(bfs.from)
-	for _, word := range bfs.wordsList {
-		if len(word) == wordLength && word != bfs.from {
-			bfs.usefulWords = append(bfs.usefulWords, word)
+func (bfs *BFSSolver) buildSearchTree() *SearchTreeNode {
+	onSolution := make(map[*BFSWordTreeNode]interface{})
+	for _, node := range bfs.solutions {
+		if node.Depth() != bfs.bestSolutionDepth {
+			continue
+		}
+		for tmpNode := node; tmpNode != nil; tmpNode = tmpNode.PreviousElement {
+			onSolution[tmpNode] = nil
 		}
 	}
+	return bfs.wordTree.searchTree(onSolution)
+}
+
+// SetObserver implements the ObservableSolver interface
+func (bfs *BFSSolver) SetObserver(observer SearchObserver) {
+	bfs.observer = observer
+}
+
+func (bfs *BFSSolver) notify(kind SearchEventKind, node *BFSWordTreeNode) {
+	if bfs.observer == nil {
+		return
+	}
+	event := SearchEvent{Kind: kind, Solver: "bfs", Word: node.Word, Depth: node.Depth() - 1}
+	if node.PreviousElement != nil {
+		event.Parent = node.PreviousElement.Word
+	}
+	if kind == SolutionFound {
+		event.Chain = node.GetSolution()
+	}
+	notify(bfs.observer, event)
+}
+
+// SetChainConstraint implements the ConstrainedSolver interface
+func (bfs *BFSSolver) SetChainConstraint(constraint *ChainConstraint) {
+	bfs.constraint = constraint
+}
+
+// ChainConstraint implements the ConstrainedSolver interface
+func (bfs *BFSSolver) ChainConstraint() *ChainConstraint {
+	return bfs.constraint
+}
+
+// SetMoveGenerator implements the MoveGeneratingSolver interface
+func (bfs *BFSSolver) SetMoveGenerator(moves MoveGenerator) {
+	bfs.moves = moves
+}
+
+// MoveGenerator implements the MoveGeneratingSolver interface
+func (bfs *BFSSolver) MoveGenerator() MoveGenerator {
+	return bfs.moves
+}
+
+// SetContext implements the CancellableSolver interface
+func (bfs *BFSSolver) SetContext(ctx context.Context) {
+	bfs.ctx = ctx
+}
+
+// Context implements the CancellableSolver interface
+func (bfs *BFSSolver) Context() context.Context {
+	return bfs.ctx
+}
+
+// IsSymmetric implements the SymmetricSolver interface, BFS gives every shortest
+// word chain as long as its moves are symmetric
+func (bfs *BFSSolver) IsSymmetric() bool {
+	return symmetricMoves(bfs.moves)
+}
+
+// RecordSearchTree implements the SearchTreeRecorder interface
+func (bfs *BFSSolver) RecordSearchTree(record bool) {
+	bfs.recordTree = record
+}
+
+// SearchTree implements the SearchTreeRecorder interface. It return the tree
+// explored by the last search, or nil if recording was disabled
+func (bfs *BFSSolver) SearchTree() *SearchTreeNode {
+	return bfs.searchTree
+}
+
+func (bfs *BFSSolver) getUsefulWordOnly() {
+	bfs.usefulWords = candidateWords(bfs.moves, bfs.from, bfs.wordsList)
 }
 
 func (bfs *BFSSolver) listPossibleNextWords(word string) []string {
 	var possibleNewWords []string
 	for _, nextWord := range bfs.usefulWords {
-		if isPossibleNextWord(nextWord, word) {
+		if allowsMove(bfs.moves, bfs.constraint, word, nextWord, bfs.to) {
 			possibleNewWords = append(possibleNewWords, nextWord)
 		}
 	}
@@ -210,3 +210,29 @@ func TestBFSSolver_FindWordChains(t *testing.T) {
 	_, err := solver.FindWordChains("dummy", "to", []string{})
 	assert.NotNil(t, err)
 }
+
+func TestBFSSolver_SearchTree(t *testing.T) {
+	solver := NewBFSSolver()
+	_, err := solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Nil(t, solver.SearchTree())
+
+	solver.RecordSearchTree(true)
+	_, err = solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	tree := solver.SearchTree()
+	assert.Equal(t, "cat", tree.Word)
+	assert.True(t, tree.OnSolution)
+	var solutionLeaves []string
+	var walk func(node *SearchTreeNode)
+	walk = func(node *SearchTreeNode) {
+		if node.Word == "dog" && node.OnSolution {
+			solutionLeaves = append(solutionLeaves, node.Word)
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(tree)
+	assert.Equal(t, 2, len(solutionLeaves))
+}
@@ -0,0 +1,348 @@
+package wordchainsresolver
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// DictionaryChecksum return a checksum identifying a word list, the same
+// words in the same order always give the same checksum
+func DictionaryChecksum(wordList []string) string {
+	hash := sha256.New()
+	for _, word := range wordList {
+		hash.Write([]byte(word))
+		hash.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// checksumMemo remembers the checksum of the last word list, as solvers are
+// given word lists with the same words again and again. The words are kept
+// to compare the next word list with, so a list changed in place is noticed
+type checksumMemo struct {
+	wordList []string
+	checksum string
+}
+
+func (memo *checksumMemo) get(wordList []string) string {
+	if memo.checksum == "" || !sameWords(wordList, memo.wordList) {
+		memo.wordList = append([]string(nil), wordList...)
+		memo.checksum = DictionaryChecksum(wordList)
+	}
+	return memo.checksum
+}
+
+func sameWords(wordList, otherList []string) bool {
+	if len(wordList) != len(otherList) {
+		return false
+	}
+	for index, word := range wordList {
+		if word != otherList[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// SymmetricSolver is implemented by solvers whose solutions from a word to
+// another, reversed, are the solutions they would give from the other word
+// to the first one. A CachingSolver only reverses the solutions of those
+type SymmetricSolver interface {
+	IsSymmetric() bool
+}
+
+// CacheStats are the counters of a CachingSolver. Hits include symmetric hits,
+// which are answered by reversing the chains of the reversed pair, and store
+// hits, which are answered by the SolutionStore
+type CacheStats struct {
+	Hits          int
+	SymmetricHits int
+	StoreHits     int
+	Misses        int
+	Size          int
+	Capacity      int
+}
+
+type cacheKey struct {
+	solver   string
+	checksum string
+	from     string
+	to       string
+}
+
+type cacheEntry struct {
+	key    cacheKey
+	chains [][]string
+}
+
+// CachingSolver is a Solver decorator keeping the most recently used
+// solutions. Solutions are stored per dictionary checksum and per solver key,
+// which must tell the algorithm and options of the decorated Solver apart. The
+// chain constraint and the moves of the decorated Solver are added to the key,
+// searches with custom MoveFunc rules are never cached. Chain constraints, move
+// generators and contexts are given to the decorated Solver, if it supports them
+type CachingSolver struct {
+	solver    Solver
+	solverKey string
+	capacity  int
+	entries   map[cacheKey]*list.Element
+	recency   *list.List
+	checksums checksumMemo
+	stats     CacheStats
+	mutex     sync.Mutex
+	// solutionStore is the optional second level cache
+	solutionStore *SolutionStore
+}
+
+// NewCachingSolver is a CachingSolver constructor keeping at most capacity solutions
+func NewCachingSolver(solver Solver, solverKey string, capacity int) *CachingSolver {
+	return &CachingSolver{
+		solver:    solver,
+		solverKey: solverKey,
+		capacity:  capacity,
+		entries:   make(map[cacheKey]*list.Element),
+		recency:   list.New(),
+	}
+}
+
+// UseStore makes the cache look for missing solutions in a SolutionStore, and
+// add new solutions to it, keyed by the checksum of the searched word list.
+// The store is not saved by the cache
+func (cache *CachingSolver) UseStore(store *SolutionStore) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.solutionStore = store
+}
+
+// FindWordChains implements the Solver interface. Cached solutions of (from, to)
+// are returned first, then reversed cached solutions of (to, from) if the
+// decorated Solver is a SymmetricSolver, then the same from the SolutionStore if
+// any, then the decorated Solver is called and its solutions are cached. Errors
+// are not cached
+func (cache *CachingSolver) FindWordChains(from string, to string, wordList []string) ([][]string, error) {
+	solverKey, ok := cache.settingsKey()
+	if !ok {
+		cache.mutex.Lock()
+		cache.stats.Misses++
+		cache.mutex.Unlock()
+		return cache.solver.FindWordChains(from, to, wordList)
+	}
+	cache.mutex.Lock()
+	checksum := cache.checksums.get(wordList)
+	if chains, ok := cache.lookup(cacheKey{solver: solverKey, checksum: checksum, from: from, to: to}); ok {
+		cache.stats.Hits++
+		cache.mutex.Unlock()
+		return chains, nil
+	}
+	if chains, ok := cache.lookupReversed(cacheKey{solver: solverKey, checksum: checksum, from: to, to: from}); ok {
+		cache.stats.Hits++
+		cache.stats.SymmetricHits++
+		cache.mutex.Unlock()
+		return reverseChains(chains), nil
+	}
+	solutionStore := cache.solutionStore
+	if chains, ok := cache.lookupStore(solutionStore, solverKey, checksum, from, to); ok {
+		cache.mutex.Unlock()
+		return chains, nil
+	}
+	cache.stats.Misses++
+	cache.mutex.Unlock()
+
+	chains, err := cache.solver.FindWordChains(from, to, wordList)
+	if err != nil {
+		return nil, err
+	}
+	if solutionStore != nil {
+		solutionStore.Put(solverKey, checksum, from, to, chains)
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.store(cacheKey{solver: solverKey, checksum: checksum, from: from, to: to}, copyChains(chains))
+	return chains, nil
+}
+
+// settingsKey return the solver key followed by the chain constraint and the
+// moves of the decorated Solver, false if its moves are a custom rule
+func (cache *CachingSolver) settingsKey() (string, bool) {
+	solverKey := cache.solverKey
+	if constrainedSolver, ok := cache.solver.(ConstrainedSolver); ok && constrainedSolver.ChainConstraint() != nil {
+		solverKey += fmt.Sprintf(" constraint=%+v", *constrainedSolver.ChainConstraint())
+	}
+	if movesSolver, ok := cache.solver.(MoveGeneratingSolver); ok {
+		name, ok := moveGeneratorName(movesSolver.MoveGenerator())
+		if !ok {
+			return "", false
+		}
+		if name != "" {
+			solverKey += " moves=" + name
+		}
+	}
+	return solverKey, true
+}
+
+// SetChainConstraint implements the ConstrainedSolver interface, the
+// constraint is ignored if the decorated Solver does not support it
+func (cache *CachingSolver) SetChainConstraint(constraint *ChainConstraint) {
+	if constrainedSolver, ok := cache.solver.(ConstrainedSolver); ok {
+		constrainedSolver.SetChainConstraint(constraint)
+	}
+}
+
+// ChainConstraint implements the ConstrainedSolver interface
+func (cache *CachingSolver) ChainConstraint() *ChainConstraint {
+	if constrainedSolver, ok := cache.solver.(ConstrainedSolver); ok {
+		return constrainedSolver.ChainConstraint()
+	}
+	return nil
+}
+
+// SetMoveGenerator implements the MoveGeneratingSolver interface, the moves
+// are ignored if the decorated Solver does not support them
+func (cache *CachingSolver) SetMoveGenerator(moves MoveGenerator) {
+	if movesSolver, ok := cache.solver.(MoveGeneratingSolver); ok {
+		movesSolver.SetMoveGenerator(moves)
+	}
+}
+
+// MoveGenerator implements the MoveGeneratingSolver interface
+func (cache *CachingSolver) MoveGenerator() MoveGenerator {
+	if movesSolver, ok := cache.solver.(MoveGeneratingSolver); ok {
+		return movesSolver.MoveGenerator()
+	}
+	return nil
+}
+
+// SetContext implements the CancellableSolver interface, the context is
+// ignored if the decorated Solver does not support it
+func (cache *CachingSolver) SetContext(ctx context.Context) {
+	if cancellableSolver, ok := cache.solver.(CancellableSolver); ok {
+		cancellableSolver.SetContext(ctx)
+	}
+}
+
+// Context implements the CancellableSolver interface
+func (cache *CachingSolver) Context() context.Context {
+	if cancellableSolver, ok := cache.solver.(CancellableSolver); ok {
+		return cancellableSolver.Context()
+	}
+	return nil
+}
+
+// decoratedSolver return the Solver searching for solver, which is solver
+// itself unless it is a CachingSolver
+func decoratedSolver(solver Solver) Solver {
+	if cache, ok := solver.(*CachingSolver); ok {
+		return decoratedSolver(cache.solver)
+	}
+	return solver
+}
+
+// lookupStore return the chains of (from, to), or the reversed chains of
+// (to, from), found in a SolutionStore and keeps them in memory
+func (cache *CachingSolver) lookupStore(solutionStore *SolutionStore, solverKey, checksum, from, to string) ([][]string, bool) {
+	if solutionStore == nil {
+		return nil, false
+	}
+	chains, ok := solutionStore.Get(solverKey, checksum, from, to)
+	if !ok && cache.isSymmetric() {
+		chains, ok = solutionStore.Get(solverKey, checksum, to, from)
+		if ok {
+			chains = reverseChains(chains)
+			cache.stats.SymmetricHits++
+		}
+	}
+	if !ok {
+		return nil, false
+	}
+	cache.stats.Hits++
+	cache.stats.StoreHits++
+	cache.store(cacheKey{solver: solverKey, checksum: checksum, from: from, to: to}, copyChains(chains))
+	return chains, true
+}
+
+// lookup return a copy of cached chains and marks them as recently used
+func (cache *CachingSolver) lookup(key cacheKey) ([][]string, bool) {
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	cache.recency.MoveToFront(element)
+	return copyChains(element.Value.(*cacheEntry).chains), true
+}
+
+// lookupReversed is lookup for the reversed pair of key, if the decorated
+// Solver is a SymmetricSolver
+func (cache *CachingSolver) lookupReversed(key cacheKey) ([][]string, bool) {
+	if !cache.isSymmetric() {
+		return nil, false
+	}
+	return cache.lookup(key)
+}
+
+func (cache *CachingSolver) isSymmetric() bool {
+	symmetricSolver, ok := cache.solver.(SymmetricSolver)
+	return ok && symmetricSolver.IsSymmetric()
+}
+
+// store adds chains to the cache, evicting the least recently used ones if full
+func (cache *CachingSolver) store(key cacheKey, chains [][]string) {
+	if cache.capacity <= 0 {
+		return
+	}
+	if element, ok := cache.entries[key]; ok {
+		element.Value.(*cacheEntry).chains = chains
+		cache.recency.MoveToFront(element)
+		return
+	}
+	cache.entries[key] = cache.recency.PushFront(&cacheEntry{key: key, chains: chains})
+	for cache.recency.Len() > cache.capacity {
+		oldest := cache.recency.Back()
+		cache.recency.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// Stats return the cache counters
+func (cache *CachingSolver) Stats() CacheStats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	stats := cache.stats
+	stats.Size = cache.recency.Len()
+	stats.Capacity = cache.capacity
+	return stats
+}
+
+// Clear removes every cached solution and resets the counters
+func (cache *CachingSolver) Clear() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.entries = make(map[cacheKey]*list.Element)
+	cache.recency.Init()
+	cache.stats = CacheStats{}
+}
+
+func copyChains(chains [][]string) [][]string {
+	if chains == nil {
+		return nil
+	}
+	copiedChains := make([][]string, len(chains))
+	for index, chain := range chains {
+		copiedChains[index] = append([]string(nil), chain...)
+	}
+	return copiedChains
+}
+
+func reverseChains(chains [][]string) [][]string {
+	if chains == nil {
+		return nil
+	}
+	reversedChains := make([][]string, len(chains))
+	for index, chain := range chains {
+		reversedChains[index] = flipStringSlice(chain)
+	}
+	return reversedChains
+}
@@ -0,0 +1,166 @@
+package wordchainsresolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// MockCountingSolver counts the searches it runs with BFS
+type MockCountingSolver struct {
+	calls int
+}
+
+func (solver *MockCountingSolver) FindWordChains(from string, to string, wordList []string) ([][]string, error) {
+	solver.calls++
+	return NewBFSSolver().FindWordChains(from, to, wordList)
+}
+
+func (solver *MockCountingSolver) IsSymmetric() bool {
+	return true
+}
+
+func TestDictionaryChecksum(t *testing.T) {
+	assert.Equal(t, DictionaryChecksum([]string{"cat", "dog"}), DictionaryChecksum([]string{"cat", "dog"}))
+	assert.NotEqual(t, DictionaryChecksum([]string{"cat", "dog"}), DictionaryChecksum([]string{"catdog"}))
+	assert.NotEqual(t, DictionaryChecksum([]string{"cat", "dog"}), DictionaryChecksum([]string{"dog", "cat"}))
+
+	memo := &checksumMemo{}
+	wordList := []string{"cat", "dog"}
+	assert.Equal(t, DictionaryChecksum(wordList), memo.get(wordList))
+	otherList := []string{"cot", "dog"}
+	assert.Equal(t, DictionaryChecksum(otherList), memo.get(otherList))
+	// a list changed in place is another list
+	otherList[0] = "cat"
+	assert.Equal(t, DictionaryChecksum(wordList), memo.get(otherList))
+	// a copy of the list is the same list
+	assert.Equal(t, DictionaryChecksum(wordList), memo.get(append([]string(nil), wordList...)))
+	assert.Equal(t, DictionaryChecksum(nil), memo.get(nil))
+}
+
+func TestCachingSolver_FindWordChains(t *testing.T) {
+	solver := &MockCountingSolver{}
+	cache := NewCachingSolver(solver, "bfs", 10)
+	expected := [][]string{{"cat", "cot", "cog", "dog"}, {"cat", "cot", "dot", "dog"}}
+
+	result, err := cache.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+	result[0][0] = "modified"
+	result, err = cache.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+	assert.Equal(t, 1, solver.calls)
+
+	result, err = cache.FindWordChains("dog", "cat", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"dog", "cog", "cot", "cat"}, {"dog", "dot", "cot", "cat"}}, result)
+	assert.Equal(t, 1, solver.calls)
+	assert.Equal(t, CacheStats{Hits: 2, SymmetricHits: 1, Misses: 1, Size: 1, Capacity: 10}, cache.Stats())
+
+	// another dictionary is another cache key
+	_, err = cache.FindWordChains("cat", "dog", []string{"cat", "cot", "cog", "dog"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, solver.calls)
+
+	_, err = cache.FindWordChains("cat", "dummy", mockWordsList_BeamSolver)
+	assert.Equal(t, ErrorWordLengthDoesNotMatch, err)
+	assert.Equal(t, 2, cache.Stats().Size)
+
+	cache.Clear()
+	assert.Equal(t, CacheStats{Capacity: 10}, cache.Stats())
+}
+
+func TestCachingSolver_Eviction(t *testing.T) {
+	solver := &MockCountingSolver{}
+	cache := NewCachingSolver(solver, "bfs", 2)
+	for _, pair := range [][2]string{{"cat", "dog"}, {"cat", "cot"}, {"cat", "dog"}, {"cut", "hut"}, {"cat", "dog"}, {"cat", "cot"}} {
+		_, err := cache.FindWordChains(pair[0], pair[1], mockWordsList_BeamSolver)
+		assert.Nil(t, err)
+	}
+	// cat -> cot was evicted by cut -> hut as cat -> dog was used more recently
+	assert.Equal(t, 4, solver.calls)
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 4, Size: 2, Capacity: 2}, cache.Stats())
+
+	cache = NewCachingSolver(solver, "bfs", 0)
+	_, _ = cache.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	_, _ = cache.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Equal(t, 0, cache.Stats().Hits)
+}
+
+func TestCachingSolver_Symmetric(t *testing.T) {
+	assert.True(t, NewBFSSolver().IsSymmetric())
+	assert.True(t, NewAStarSolver().IsSymmetric())
+	assert.False(t, NewAStarSolverWithHeuristic(NewAlphabetHeuristic()).IsSymmetric())
+	solver := NewBFSSolver()
+	solver.SetMoveGenerator(CombineMoves(SubstitutionMoves{}, MoveFunc(isPossibleNextWord)))
+	assert.False(t, solver.IsSymmetric())
+
+	// greedy chains are not reversed, greedy may not give them from the other word
+	cache := NewCachingSolver(NewGreedySolver(), "greedy", 10)
+	_, err := cache.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	_, err = cache.FindWordChains("dog", "cat", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, CacheStats{Misses: 2, Size: 2, Capacity: 10}, cache.Stats())
+}
+
+func TestCachingSolver_SolverSettings(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockListFactory{words: []string{"cat", "act", "cot", "cog", "dog", "dot"}})
+	assert.Nil(t, wcr.LoadDB())
+	solver := NewBFSSolver()
+	cache := NewCachingSolver(solver, "bfs", 10)
+
+	// settings are given to the decorated solver
+	constraint := &ChainConstraint{BannedLetters: "g"}
+	cache.SetChainConstraint(constraint)
+	assert.Equal(t, constraint, solver.ChainConstraint())
+	assert.Equal(t, constraint, cache.ChainConstraint())
+	result, err := cache.FindWordChains("cat", "dog", wcr.wordList)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "dot", "dog"}}, result)
+	cache.SetChainConstraint(nil)
+	cache.SetMoveGenerator(AnagramMoves{})
+	assert.Equal(t, AnagramMoves{}, solver.MoveGenerator())
+	result, err = cache.FindWordChains("cat", "act", wcr.wordList)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "act"}}, result)
+	cache.SetMoveGenerator(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cache.SetContext(ctx)
+	assert.Equal(t, ctx, solver.Context())
+	_, err = cache.FindWordChains("cot", "dot", wcr.wordList)
+	assert.Equal(t, context.Canceled, err)
+	cache.SetContext(nil)
+
+	// chains found under other settings are not given back
+	result, err = wcr.SolveWith(cache, "cat", "dog", WithChainConstraint(ChainConstraint{BannedLetters: "o"}))
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	result, err = wcr.SolveWith(cache, "cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}, {"cat", "cot", "dot", "dog"}}, result)
+	result, err = wcr.SolveWith(cache, "cat", "act", WithMoveGenerator(CombineMoves(SubstitutionMoves{}, AnagramMoves{})))
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "act"}}, result)
+	result, err = wcr.SolveWith(cache, "cat", "act")
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, 0, cache.Stats().Hits)
+	_, err = wcr.SolveWith(cache, "cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cache.Stats().Hits)
+
+	// searches with custom rules are never cached
+	moves := MoveFunc(func(word, nextWord string) bool { return isPossibleNextWord(word, nextWord) })
+	for range []int{0, 1} {
+		result, err = wcr.SolveWith(cache, "cat", "cot", WithMoveGenerator(moves))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"cat", "cot"}}, result)
+	}
+	assert.Equal(t, 1, cache.Stats().Hits)
+	assert.Nil(t, solver.MoveGenerator())
+	assert.Nil(t, solver.ChainConstraint())
+}
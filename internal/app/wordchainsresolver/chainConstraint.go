@@ -0,0 +1,99 @@
+package wordchainsresolver
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrorChainConstraintNotSupported is trigger when a chain constraint is given
+	// to a solver which does not implement the ConstrainedSolver interface
+	ErrorChainConstraintNotSupported = errors.New("query : the solver does not support chain constraints")
+
+	// ErrorChainConstraintWithVia is trigger when every position must change in a
+	// query with via words
+	ErrorChainConstraintWithVia = errors.New("query : every position constraint can not be combined with via words")
+)
+
+// ChainConstraint restricts the moves a solver may make and the word chains
+// it may return. The zero value does not restrict anything
+type ChainConstraint struct {
+	// LockedPositions are the letter positions, counted from 0, which may never change
+	LockedPositions []int
+	// ChangeEveryPosition makes a word chain valid only if every letter
+	// position was changed at least once
+	ChangeEveryPosition bool
+	// BannedLetters may not be used by intermediate words, the first and
+	// last words may use them
+	BannedLetters string
+}
+
+// ConstrainedSolver is implemented by solvers generating their moves under a
+// ChainConstraint. A nil constraint removes the constraint
+type ConstrainedSolver interface {
+	SetChainConstraint(constraint *ChainConstraint)
+	ChainConstraint() *ChainConstraint
+}
+
+// AllowsMove tells if a solver searching word chains ending with goal may
+// go from word to nextWord : letters at locked positions must be kept, and
+// nextWord must not use a banned letter unless it is goal. Whether nextWord
+// is a move from word is told by the solver MoveGenerator
+func (constraint *ChainConstraint) AllowsMove(word, nextWord, goal string) bool {
+	if constraint == nil {
+		return true
+	}
+	if len(constraint.LockedPositions) != 0 {
+		wordChars := []rune(word)
+		nextWordChars := []rune(nextWord)
+		for _, position := range constraint.LockedPositions {
+			if position >= 0 && position < len(wordChars) && position < len(nextWordChars) && wordChars[position] != nextWordChars[position] {
+				return false
+			}
+		}
+	}
+	return nextWord == goal || !strings.ContainsAny(nextWord, constraint.BannedLetters)
+}
+
+// AcceptsChain tells if a word chain satisfies the constraint, every move
+// being checked with AllowsMove
+func (constraint *ChainConstraint) AcceptsChain(chain []string) bool {
+	if len(chain) == 0 {
+		return false
+	}
+	goal := chain[len(chain)-1]
+	for index := 1; index < len(chain); index++ {
+		if !constraint.AllowsMove(chain[index-1], chain[index], goal) {
+			return false
+		}
+	}
+	return constraint.changesEveryPosition(chain)
+}
+
+// IsAllowedIntermediateWord tells if word may be used between the first and
+// last words of a chain
+func (constraint *ChainConstraint) IsAllowedIntermediateWord(word string) bool {
+	return constraint == nil || !strings.ContainsAny(word, constraint.BannedLetters)
+}
+
+// changesEveryPosition only checks the ChangeEveryPosition rule, solvers
+// already generate allowed moves
+func (constraint *ChainConstraint) changesEveryPosition(chain []string) bool {
+	if constraint == nil || !constraint.ChangeEveryPosition {
+		return true
+	}
+	previousChars := []rune(chain[0])
+	changed := make([]bool, len(previousChars))
+	unchangedPositions := len(previousChars)
+	for _, word := range chain[1:] {
+		wordChars := []rune(word)
+		for position := range changed {
+			if !changed[position] && position < len(wordChars) && position < len(previousChars) && wordChars[position] != previousChars[position] {
+				changed[position] = true
+				unchangedPositions--
+			}
+		}
+		previousChars = wordChars
+	}
+	return unchangedPositions == 0
+}
@@ -0,0 +1,143 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockWordsList_ChainConstraint = []string{"cat", "cot", "cog", "dog", "dot", "cut", "hut", "hot", "hog", "hat"}
+
+// shortestConstrainedChain return the length of the shortest chain without
+// repeated words satisfying constraint, by trying every chain
+func shortestConstrainedChain(from, to string, wordList []string, constraint *ChainConstraint) int {
+	best := 0
+	var search func(chain []string)
+	search = func(chain []string) {
+		word := chain[len(chain)-1]
+		if best != 0 && len(chain) >= best {
+			return
+		}
+		if word == to && constraint.AcceptsChain(chain) {
+			best = len(chain)
+			return
+		}
+		for _, nextWord := range wordList {
+			if !isWordInList(nextWord, chain) && isPossibleNextWord(nextWord, word) && constraint.AllowsMove(word, nextWord, to) {
+				search(append(append([]string(nil), chain...), nextWord))
+			}
+		}
+	}
+	search([]string{from})
+	return best
+}
+
+func TestChainConstraint_AllowsMove(t *testing.T) {
+	var noConstraint *ChainConstraint
+	assert.True(t, noConstraint.AllowsMove("cat", "cot", "dog"))
+	assert.True(t, noConstraint.AllowsMove("cat", "dog", "dog"))
+
+	constraint := &ChainConstraint{LockedPositions: []int{1, 5, -1}, BannedLetters: "ou"}
+	assert.True(t, constraint.AllowsMove("cat", "hat", "dog"))
+	assert.False(t, constraint.AllowsMove("cat", "cot", "dog"))
+	assert.False(t, constraint.AllowsMove("hot", "dot", "dog"))
+	assert.True(t, constraint.AllowsMove("hot", "dot", "dot"))
+	assert.True(t, constraint.AllowsMove("hat", "ha", "ha"))
+	assert.False(t, constraint.AllowsMove("hat", "hit", "hit"))
+	assert.True(t, constraint.IsAllowedIntermediateWord("cat"))
+	assert.False(t, constraint.IsAllowedIntermediateWord("cut"))
+	assert.True(t, noConstraint.IsAllowedIntermediateWord("cut"))
+}
+
+func TestChainConstraint_AcceptsChain(t *testing.T) {
+	constraint := &ChainConstraint{ChangeEveryPosition: true}
+	assert.True(t, constraint.AcceptsChain([]string{"cat", "cot", "cog", "hog", "hot"}))
+	assert.False(t, constraint.AcceptsChain([]string{"cat", "hat", "hot"}))
+	assert.True(t, constraint.AcceptsChain([]string{"cat", "dog"}))
+	assert.False(t, constraint.AcceptsChain([]string{"cat", "cot"}))
+	assert.False(t, constraint.AcceptsChain(nil))
+	assert.True(t, (&ChainConstraint{}).AcceptsChain([]string{"cat", "hat", "hot"}))
+	assert.False(t, (&ChainConstraint{BannedLetters: "h"}).AcceptsChain([]string{"cat", "hat", "hot", "dot"}))
+	assert.True(t, (&ChainConstraint{BannedLetters: "o"}).AcceptsChain([]string{"cat", "hat", "hot"}))
+}
+
+func TestChainConstraint_OptimalSolvers(t *testing.T) {
+	constraints := []ChainConstraint{
+		{},
+		{LockedPositions: []int{0}},
+		{LockedPositions: []int{2}},
+		{ChangeEveryPosition: true},
+		{BannedLetters: "c"},
+		{BannedLetters: "o", LockedPositions: []int{1}},
+		{ChangeEveryPosition: true, BannedLetters: "d"},
+	}
+	for _, solver := range []Solver{NewBFSSolver(), NewAStarSolver()} {
+		wcr := NewWordChainsResolver(solver, &MockListFactory{words: mockWordsList_ChainConstraint})
+		assert.Nil(t, wcr.LoadDB())
+		for _, constraint := range constraints {
+			constraint := constraint
+			for _, from := range mockWordsList_ChainConstraint {
+				for _, to := range mockWordsList_ChainConstraint {
+					expectedLength := shortestConstrainedChain(from, to, mockWordsList_ChainConstraint, &constraint)
+					result, err := wcr.Solve(from, to, WithChainConstraint(constraint))
+					assert.Nil(t, err)
+					if expectedLength == 0 {
+						assert.Empty(t, result, "%s -> %s %+v", from, to, constraint)
+						continue
+					}
+					assert.NotEmpty(t, result, "%s -> %s %+v", from, to, constraint)
+					for _, chain := range result {
+						assert.Equal(t, expectedLength, len(chain), "%v %+v", chain, constraint)
+						assert.True(t, constraint.AcceptsChain(chain), "%v %+v", chain, constraint)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestChainConstraint_Solve(t *testing.T) {
+	for _, solver := range []Solver{NewBFSSolver(), NewAStarSolver(), NewBeamSolver(0)} {
+		wcr := NewWordChainsResolver(solver, &MockListFactory{words: mockWordsList_ChainConstraint})
+		assert.Nil(t, wcr.LoadDB())
+
+		result, err := wcr.Solve("cat", "hot", WithChainConstraint(ChainConstraint{ChangeEveryPosition: true}))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"cat", "cot", "cog", "hog", "hot"}}, result)
+
+		result, err = wcr.Solve("cat", "dog", WithChainConstraint(ChainConstraint{LockedPositions: []int{1}}))
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+
+		result, err = wcr.Solve("cat", "dog", WithChainConstraint(ChainConstraint{BannedLetters: "c"}), Via("hat"))
+		assert.Nil(t, err)
+		assertChainsHave(t, result, 5, []string{"hat", "hot"}, []string{"cot"})
+
+		// the constraint is only used by the constrained query
+		result, err = wcr.Solve("cat", "hot")
+		assert.Nil(t, err)
+		assertChainsHave(t, result, 3, nil, nil)
+
+		_, err = wcr.Solve("cat", "dog", WithChainConstraint(ChainConstraint{ChangeEveryPosition: true}), Via("hot"))
+		assert.Equal(t, ErrorChainConstraintWithVia, err)
+		_, err = wcr.Solve("cat", "dog", WithChainConstraint(ChainConstraint{BannedLetters: "h"}), Via("hot"))
+		assert.Equal(t, ErrorConflictingConstraints, err)
+	}
+
+	// greedy is not complete, it only has to return constrained chains
+	wcr := NewWordChainsResolver(NewGreedySolver(), &MockListFactory{words: mockWordsList_ChainConstraint})
+	assert.Nil(t, wcr.LoadDB())
+	result, err := wcr.Solve("cat", "dog", WithChainConstraint(ChainConstraint{BannedLetters: "c"}))
+	assert.Nil(t, err)
+	assertChainsHave(t, result, 5, []string{"hat", "hot"}, nil)
+	result, err = wcr.Solve("cat", "hot", WithChainConstraint(ChainConstraint{ChangeEveryPosition: true}))
+	assert.Nil(t, err)
+	for _, chain := range result {
+		assert.True(t, (&ChainConstraint{ChangeEveryPosition: true}).AcceptsChain(chain), chain)
+	}
+
+	wcr = NewWordChainsResolver(NewCachingSolver(&MockCountingSolver{}, "bfs", 10), &MockListFactory{words: mockWordsList_ChainConstraint})
+	assert.Nil(t, wcr.LoadDB())
+	_, err = wcr.Solve("cat", "dog", WithChainConstraint(ChainConstraint{}))
+	assert.Equal(t, ErrorChainConstraintNotSupported, err)
+}
@@ -0,0 +1,139 @@
+package wordchainsresolver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChainProblemKind tells what is wrong in a word chain
+type ChainProblemKind string
+
+const (
+	// UnknownWordProblem is reported when a word is not in the loaded database
+	UnknownWordProblem ChainProblemKind = "unknown word"
+	// StepProblem is reported when a word does not differ by exactly one
+	// letter from the previous word
+	StepProblem ChainProblemKind = "invalid step"
+	// RepeatedWordProblem is reported when a word is already in the chain
+	RepeatedWordProblem ChainProblemKind = "repeated word"
+	// WrongStartProblem is reported when the chain does not start with the first word
+	WrongStartProblem ChainProblemKind = "wrong start"
+	// WrongEndProblem is reported when the chain does not end with the last word
+	WrongEndProblem ChainProblemKind = "wrong end"
+	// WordLengthProblem is reported when a word length differs from the first word length
+	WordLengthProblem ChainProblemKind = "word length mismatch"
+	// ChainLengthProblem is reported when the chain does not have the expected number of steps
+	ChainLengthProblem ChainProblemKind = "chain length mismatch"
+)
+
+// ChainProblem is a problem found in a word chain, at the index of the faulty word
+type ChainProblem struct {
+	Index   int
+	Kind    ChainProblemKind
+	Message string
+}
+
+// ChainReport is the result of a word chain validation. Problems are sorted
+// by index. OptimalSteps is the number of steps of the shortest word chains
+// in the loaded database graph, or -1 if there is none
+type ChainReport struct {
+	Problems     []ChainProblem
+	Valid        bool
+	Optimal      bool
+	OptimalSteps int
+}
+
+func (report *ChainReport) add(index int, kind ChainProblemKind, format string, args ...interface{}) {
+	report.Problems = append(report.Problems, ChainProblem{Index: index, Kind: kind, Message: fmt.Sprintf(format, args...)})
+}
+
+// CheckChain validates a word chain from a word to another and reports every
+// problem with the index of the faulty word. If expectedSteps is not zero, the
+// chain must have this number of steps. The chain is optimal if it is valid
+// and as short as the shortest word chains of the loaded database graph,
+// whatever the frequency settings of the resolver
+func (wcr *WordChainsResolver) CheckChain(chain []string, from, to string, expectedSteps int) (*ChainReport, error) {
+	report := &ChainReport{OptimalSteps: -1}
+	normalizedChain := make([]string, len(chain))
+	for index, word := range chain {
+		normalizedChain[index] = wcr.Normalize(word)
+	}
+	from = wcr.Normalize(from)
+	to = wcr.Normalize(to)
+
+	if len(normalizedChain) == 0 {
+		report.add(0, WrongStartProblem, "chain is empty, it should start with %q", from)
+	} else {
+		if normalizedChain[0] != from {
+			report.add(0, WrongStartProblem, "chain starts with %q instead of %q", chain[0], from)
+		}
+		if last := len(normalizedChain) - 1; normalizedChain[last] != to {
+			report.add(last, WrongEndProblem, "chain ends with %q instead of %q", chain[last], to)
+		}
+	}
+	seenWords := make(map[string]int)
+	for index, word := range normalizedChain {
+		if !wcr.IsWordInDB(word) {
+			report.add(index, UnknownWordProblem, "%q is not in the loaded database", chain[index])
+		}
+		if firstIndex, ok := seenWords[word]; ok {
+			report.add(index, RepeatedWordProblem, "%q is already used at index %d", chain[index], firstIndex)
+		} else {
+			seenWords[word] = index
+		}
+		if index == 0 {
+			continue
+		}
+		wordLength, firstWordLength := len([]rune(word)), len([]rune(normalizedChain[0]))
+		if wordLength != firstWordLength {
+			report.add(index, WordLengthProblem, "%q has %d letters instead of %d", chain[index], wordLength, firstWordLength)
+			continue
+		}
+		previous := normalizedChain[index-1]
+		if len([]rune(previous)) != wordLength {
+			continue
+		}
+		if changedLetters := wordLength - getScoreBetweenTwoWord(previous, word); changedLetters != 1 {
+			report.add(index, StepProblem, "%q changes %d letters from %q instead of 1", chain[index], changedLetters, chain[index-1])
+		}
+	}
+	steps := len(normalizedChain) - 1
+	if expectedSteps != 0 && steps != expectedSteps {
+		report.add(steps, ChainLengthProblem, "chain has %d steps instead of %d", steps, expectedSteps)
+	}
+	sort.SliceStable(report.Problems, func(i, j int) bool {
+		return report.Problems[i].Index < report.Problems[j].Index
+	})
+	report.Valid = len(report.Problems) == 0
+
+	if !wcr.IsWordInDB(from) || !wcr.IsWordInDB(to) {
+		return report, nil
+	}
+	distanceMap, err := wcr.DistanceMapFrom(from)
+	if err != nil {
+		return nil, err
+	}
+	if distance, ok := distanceMap.Distance(to); ok {
+		report.OptimalSteps = distance
+	}
+	report.Optimal = report.Valid && steps == report.OptimalSteps
+	return report, nil
+}
+
+// String return a human readable report
+func (report *ChainReport) String() string {
+	var lines []string
+	for _, problem := range report.Problems {
+		lines = append(lines, fmt.Sprintf("#%d %s : %s", problem.Index, problem.Kind, problem.Message))
+	}
+	switch {
+	case !report.Valid:
+		lines = append(lines, "chain is invalid")
+	case report.Optimal:
+		lines = append(lines, "chain is valid and optimal")
+	default:
+		lines = append(lines, fmt.Sprintf("chain is valid but not optimal, shortest chains have %d steps", report.OptimalSteps))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,56 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordChainsResolver_CheckChain(t *testing.T) {
+	wcr := NewWordChainsResolver(NewBFSSolver(), &MockListFactory{words: []string{"cat", "cot", "cog", "dog", "dot", "cut", "dogs"}})
+	assert.Nil(t, wcr.LoadDB())
+
+	report, err := wcr.CheckChain([]string{"cat", "cot", "cog", "dog"}, "cat", "dog", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, &ChainReport{Valid: true, Optimal: true, OptimalSteps: 3}, report)
+	assert.Equal(t, "chain is valid and optimal", report.String())
+
+	report, err = wcr.CheckChain([]string{"cat", "cut", "cot", "dot", "dog"}, "cat", "dog", 0)
+	assert.Nil(t, err)
+	assert.True(t, report.Valid)
+	assert.False(t, report.Optimal)
+	assert.Equal(t, "chain is valid but not optimal, shortest chains have 3 steps", report.String())
+
+	report, err = wcr.CheckChain([]string{"cut", "cot", "xyz", "cot", "dogs", "dog"}, "cat", "dot", 3)
+	assert.Nil(t, err)
+	expectedProblems := []ChainProblem{
+		{Index: 0, Kind: WrongStartProblem, Message: `chain starts with "cut" instead of "cat"`},
+		{Index: 2, Kind: UnknownWordProblem, Message: `"xyz" is not in the loaded database`},
+		{Index: 2, Kind: StepProblem, Message: `"xyz" changes 3 letters from "cot" instead of 1`},
+		{Index: 3, Kind: RepeatedWordProblem, Message: `"cot" is already used at index 1`},
+		{Index: 3, Kind: StepProblem, Message: `"cot" changes 3 letters from "xyz" instead of 1`},
+		{Index: 4, Kind: WordLengthProblem, Message: `"dogs" has 4 letters instead of 3`},
+		{Index: 5, Kind: WrongEndProblem, Message: `chain ends with "dog" instead of "dot"`},
+		{Index: 5, Kind: ChainLengthProblem, Message: "chain has 5 steps instead of 3"},
+	}
+	assert.Equal(t, expectedProblems, report.Problems)
+	assert.False(t, report.Valid)
+	assert.False(t, report.Optimal)
+	assert.Equal(t, 2, report.OptimalSteps)
+	assert.Contains(t, report.String(), "chain is invalid")
+
+	// rare words excluded from solutions do not make optimal chains non optimal
+	wcr.UseFrequencies(FrequencyList{"cog": 10, "dot": 10}, 5)
+	report, err = wcr.CheckChain([]string{"cat", "cot", "cog", "dog"}, "cat", "dog", 0)
+	assert.Nil(t, err)
+	assert.True(t, report.Optimal)
+	report, err = wcr.CheckChain([]string{"cat", "cot", "cog", "dog"}, "cat", "dogs", 0)
+	assert.Nil(t, err)
+	assert.False(t, report.Optimal)
+	assert.Equal(t, -1, report.OptimalSteps)
+
+	report, err = wcr.CheckChain(nil, "cat", "www", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []ChainProblem{{Index: 0, Kind: WrongStartProblem, Message: `chain is empty, it should start with "cat"`}}, report.Problems)
+	assert.Equal(t, -1, report.OptimalSteps)
+}
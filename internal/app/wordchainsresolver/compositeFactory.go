@@ -0,0 +1,112 @@
+package wordchainsresolver
+
+// CompositeOperation tells how a CompositeFactory merges the words of
+// a source with the words already gathered
+type CompositeOperation int
+
+const (
+	// UnionOperation adds the words of the source which are not already gathered
+	UnionOperation CompositeOperation = iota
+	// IntersectOperation keeps only the gathered words also present in the source
+	IntersectOperation
+	// SubtractOperation removes the words of the source from the gathered words
+	SubtractOperation
+)
+
+type compositeSource struct {
+	name      string
+	factory   Factory
+	operation CompositeOperation
+}
+
+// CompositeFactory struct implements Factory interface by merging several
+// factories one after the other. Words are deduplicated and each of them
+// remembers the name of the first source it was loaded from
+type CompositeFactory struct {
+	sources     []compositeSource
+	wordSources map[string]string
+}
+
+// NewCompositeFactory is a CompositeFactory constructor
+// input : the name of the base dictionary, the factory loading it
+func NewCompositeFactory(name string, base Factory) *CompositeFactory {
+	return &CompositeFactory{
+		sources:     []compositeSource{{name: name, factory: base, operation: UnionOperation}},
+		wordSources: make(map[string]string),
+	}
+}
+
+// Union adds the words loaded by factory to the composite
+func (composite *CompositeFactory) Union(name string, factory Factory) *CompositeFactory {
+	return composite.add(name, factory, UnionOperation)
+}
+
+// Intersect keeps only the words also loaded by factory
+func (composite *CompositeFactory) Intersect(name string, factory Factory) *CompositeFactory {
+	return composite.add(name, factory, IntersectOperation)
+}
+
+// Subtract removes the words loaded by factory from the composite
+func (composite *CompositeFactory) Subtract(name string, factory Factory) *CompositeFactory {
+	return composite.add(name, factory, SubtractOperation)
+}
+
+func (composite *CompositeFactory) add(name string, factory Factory, operation CompositeOperation) *CompositeFactory {
+	composite.sources = append(composite.sources, compositeSource{name: name, factory: factory, operation: operation})
+	return composite
+}
+
+// LoadDB implements Factory interface. Sources are loaded in the order they
+// were added and merged with their operation, the word order of the first
+// source loading a word is kept
+func (composite *CompositeFactory) LoadDB() ([]string, error) {
+	var wordList []string
+	wordSources := make(map[string]string)
+	for _, source := range composite.sources {
+		sourceWords, err := source.factory.LoadDB()
+		if err != nil {
+			return nil, err
+		}
+		switch source.operation {
+		case UnionOperation:
+			for _, word := range sourceWords {
+				if _, ok := wordSources[word]; !ok {
+					wordSources[word] = source.name
+					wordList = append(wordList, word)
+				}
+			}
+		case IntersectOperation:
+			wordList = filterWordsBySet(wordList, wordSources, toWordSet(sourceWords), true)
+		case SubtractOperation:
+			wordList = filterWordsBySet(wordList, wordSources, toWordSet(sourceWords), false)
+		}
+	}
+	composite.wordSources = wordSources
+	return wordList, nil
+}
+
+func filterWordsBySet(wordList []string, wordSources map[string]string, sourceWords map[string]interface{}, keepIfPresent bool) []string {
+	var filteredWords []string
+	for _, word := range wordList {
+		if _, ok := sourceWords[word]; ok == keepIfPresent {
+			filteredWords = append(filteredWords, word)
+			continue
+		}
+		delete(wordSources, word)
+	}
+	return filteredWords
+}
+
+// WordSource return the name of the source a word was loaded from
+func (composite *CompositeFactory) WordSource(word string) (string, bool) {
+	source, ok := composite.wordSources[word]
+	return source, ok
+}
+
+func toWordSet(wordList []string) map[string]interface{} {
+	wordSet := make(map[string]interface{}, len(wordList))
+	for _, word := range wordList {
+		wordSet[word] = nil
+	}
+	return wordSet
+}
@@ -0,0 +1,58 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockListFactory struct {
+	words []string
+}
+
+func (factory *MockListFactory) LoadDB() ([]string, error) {
+	return factory.words, nil
+}
+
+func TestCompositeFactory_LoadDB(t *testing.T) {
+	base := &MockListFactory{words: []string{"cat", "cot", "cog", "cat", "dog"}}
+	team := &MockListFactory{words: []string{"dot", "cat", "cut"}}
+	removed := &MockListFactory{words: []string{"cut", "cog"}}
+
+	composite := NewCompositeFactory("base", base).Union("team", team).Subtract("removed", removed)
+	wordList, err := composite.LoadDB()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cat", "cot", "dog", "dot"}, wordList)
+
+	source, ok := composite.WordSource("cat")
+	assert.True(t, ok)
+	assert.Equal(t, "base", source)
+	source, ok = composite.WordSource("dot")
+	assert.True(t, ok)
+	assert.Equal(t, "team", source)
+	_, ok = composite.WordSource("cog")
+	assert.False(t, ok)
+
+	composite = NewCompositeFactory("base", base).Intersect("team", team)
+	wordList, err = composite.LoadDB()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cat"}, wordList)
+
+	composite = NewCompositeFactory("base", base).Union("bad", &MockBadFactory{})
+	_, err = composite.LoadDB()
+	assert.NotNil(t, err)
+}
+
+func TestWordChainsResolver_WordSource(t *testing.T) {
+	composite := NewCompositeFactory("base", &MockFactory{}).Union("team", &MockListFactory{words: []string{"dot"}})
+	wcr := NewWordChainsResolver(&MockSolver{}, composite)
+	assert.Nil(t, wcr.LoadDB())
+	source, ok := wcr.WordSource("dot")
+	assert.True(t, ok)
+	assert.Equal(t, "team", source)
+
+	wcr = NewWordChainsResolver(&MockSolver{}, &MockFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	_, ok = wcr.WordSource("cat")
+	assert.False(t, ok)
+}
@@ -0,0 +1,115 @@
+package wordchainsresolver
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+)
+
+// ErrorDistanceMapOutdated is trigger when a distance map was computed on
+// another dictionary than the loaded one
+var ErrorDistanceMapOutdated = errors.New("distance map : computed on another dictionary")
+
+// DistanceMap holds every word reachable from a word, with its distance in
+// steps and its parent on a shortest word chain from this word
+type DistanceMap struct {
+	From      string            `json:"from"`
+	Checksum  string            `json:"checksum"`
+	Distances map[string]int    `json:"distances"`
+	Parents   map[string]string `json:"parents"`
+}
+
+// NewDistanceMap runs a breadth first walk from word in a WordGraph. The
+// checksum identifies the dictionary the graph was built from
+func NewDistanceMap(graph *WordGraph, word string, checksum string) (*DistanceMap, error) {
+	index, ok := graph.indexes[word]
+	if !ok {
+		return nil, ErrorWordNotFoundInDB
+	}
+	walker := newGraphWalker(graph)
+	walker.walk(index)
+	distanceMap := &DistanceMap{
+		From:      word,
+		Checksum:  checksum,
+		Distances: make(map[string]int, len(walker.order)),
+		Parents:   make(map[string]string, len(walker.order)),
+	}
+	for _, reached := range walker.order {
+		distanceMap.Distances[graph.words[reached]] = walker.distances[reached]
+		if parent := walker.parents[reached]; parent != -1 {
+			distanceMap.Parents[graph.words[reached]] = graph.words[parent]
+		}
+	}
+	return distanceMap, nil
+}
+
+// DistanceMapFrom return the distance map of a word of the loaded database
+func (wcr *WordChainsResolver) DistanceMapFrom(word string) (*DistanceMap, error) {
+	return NewDistanceMap(wcr.Graph(), wcr.Normalize(word), wcr.DictionaryChecksum())
+}
+
+// CheckDistanceMap return ErrorDistanceMapOutdated if a distance map was not
+// computed on the loaded database
+func (wcr *WordChainsResolver) CheckDistanceMap(distanceMap *DistanceMap) error {
+	if distanceMap.Checksum != wcr.DictionaryChecksum() {
+		return ErrorDistanceMapOutdated
+	}
+	return nil
+}
+
+// Distance return the number of steps from the first word to word
+func (distanceMap *DistanceMap) Distance(word string) (int, bool) {
+	distance, ok := distanceMap.Distances[word]
+	return distance, ok
+}
+
+// ChainTo return a shortest word chain from the first word to word, or nil
+// if word can not be reached or if the parents of a loaded map do not lead
+// to the first word within the distance of word
+func (distanceMap *DistanceMap) ChainTo(word string) []string {
+	distance, ok := distanceMap.Distances[word]
+	if !ok {
+		return nil
+	}
+	wordChains := []string{word}
+	for current := word; current != distanceMap.From; {
+		parent, ok := distanceMap.Parents[current]
+		if !ok || len(wordChains) > distance {
+			return nil
+		}
+		current = parent
+		wordChains = append(wordChains, current)
+	}
+	return flipStringSlice(wordChains)
+}
+
+// Layers return the reachable words grouped by distance, each layer sorted
+func (distanceMap *DistanceMap) Layers() [][]string {
+	var layers [][]string
+	for word, distance := range distanceMap.Distances {
+		for len(layers) <= distance {
+			layers = append(layers, nil)
+		}
+		layers[distance] = append(layers[distance], word)
+	}
+	for _, layer := range layers {
+		sort.Strings(layer)
+	}
+	return layers
+}
+
+// Save writes the distance map as JSON
+func (distanceMap *DistanceMap) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(distanceMap)
+}
+
+// LoadDistanceMap reads a distance map written by Save
+func LoadDistanceMap(r io.Reader) (*DistanceMap, error) {
+	distanceMap := &DistanceMap{}
+	err := json.NewDecoder(r).Decode(distanceMap)
+	if err != nil {
+		return nil, err
+	}
+	return distanceMap, nil
+}
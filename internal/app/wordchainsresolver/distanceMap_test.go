@@ -0,0 +1,63 @@
+package wordchainsresolver
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDistanceMap(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_WordGraph)
+	distanceMap, err := NewDistanceMap(graph, "cat", "checksum")
+	assert.Nil(t, err)
+	distance, ok := distanceMap.Distance("dog")
+	assert.True(t, ok)
+	assert.Equal(t, 3, distance)
+	_, ok = distanceMap.Distance("code")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"cat", "cot", "cog", "dog"}, distanceMap.ChainTo("dog"))
+	assert.Equal(t, []string{"cat"}, distanceMap.ChainTo("cat"))
+	assert.Nil(t, distanceMap.ChainTo("code"))
+	assert.Equal(t, [][]string{{"cat"}, {"cot"}, {"cog", "dot"}, {"dog"}}, distanceMap.Layers())
+
+	_, err = NewDistanceMap(graph, "cut", "checksum")
+	assert.Equal(t, ErrorWordNotFoundInDB, err)
+}
+
+func TestDistanceMap_Save(t *testing.T) {
+	distanceMap, err := NewDistanceMap(NewWordGraph(mockWordsList_WordGraph), "code", "checksum")
+	assert.Nil(t, err)
+	buffer := &bytes.Buffer{}
+	assert.Nil(t, distanceMap.Save(buffer))
+	loadedMap, err := LoadDistanceMap(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, distanceMap, loadedMap)
+	assert.Equal(t, []string{"code", "cove", "love"}, loadedMap.ChainTo("love"))
+
+	_, err = LoadDistanceMap(strings.NewReader("{"))
+	assert.NotNil(t, err)
+
+	// broken parents do not make ChainTo loop
+	delete(loadedMap.Parents, "cove")
+	assert.Nil(t, loadedMap.ChainTo("love"))
+	loadedMap.Parents["cove"] = "love"
+	assert.Nil(t, loadedMap.ChainTo("love"))
+	loadedMap.Distances["lost"] = 1
+	assert.Nil(t, loadedMap.ChainTo("lost"))
+}
+
+func TestWordChainsResolver_DistanceMapFrom(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	distanceMap, err := wcr.DistanceMapFrom("dog")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dog", "cog", "cot", "cat"}, distanceMap.ChainTo("cat"))
+	assert.Nil(t, wcr.CheckDistanceMap(distanceMap))
+	distanceMap.Checksum = "another checksum"
+	assert.Equal(t, ErrorDistanceMapOutdated, wcr.CheckDistanceMap(distanceMap))
+	_, err = wcr.DistanceMapFrom("www")
+	assert.Equal(t, ErrorWordNotFoundInDB, err)
+}
@@ -9,6 +9,7 @@ import (
 //FileLoaderFactory struct implements Factory interface
 type FileLoaderFactory struct {
 	path string
+	raw  bool
 }
 
 // NewFileLoaderFactory is a FileLoaderFactory constructor
@@ -16,6 +17,12 @@ func NewFileLoaderFactory(path string) *FileLoaderFactory {
 	return &FileLoaderFactory{path: path}
 }
 
+// NewRawFileLoaderFactory is a FileLoaderFactory constructor which keeps
+// words exactly as they are written in the file. Use it with a NormalizedFactory
+func NewRawFileLoaderFactory(path string) *FileLoaderFactory {
+	return &FileLoaderFactory{path: path, raw: true}
+}
+
 // LoadDB implement Factory interface. It read a file containing a word per line
 func (fileLoader *FileLoaderFactory) LoadDB() ([]string, error) {
 	var wordList []string
@@ -26,7 +33,11 @@ func (fileLoader *FileLoaderFactory) LoadDB() ([]string, error) {
 	defer file.Close()
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
-		wordList = append(wordList, strings.ToLower(scanner.Text()))
+		word := scanner.Text()
+		if !fileLoader.raw {
+			word = strings.ToLower(word)
+		}
+		wordList = append(wordList, word)
 	}
 
 	if err := scanner.Err(); err != nil {
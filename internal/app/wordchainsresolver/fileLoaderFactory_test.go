@@ -17,3 +17,10 @@ func TestFileLoaderFactory_LoadDB(t *testing.T) {
 	_, err = flf.LoadDB()
 	assert.NotNil(t, err)
 }
+
+func TestNewRawFileLoaderFactory(t *testing.T) {
+	flf := NewRawFileLoaderFactory(os.Getenv("GOPATH") + "/src/github.com/clnbs/wordChains/assets/app/small_en.txt")
+	wordList, err := flf.LoadDB()
+	assert.Nil(t, err)
+	assert.Equal(t, 58110, len(wordList))
+}
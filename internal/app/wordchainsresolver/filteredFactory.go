@@ -0,0 +1,173 @@
+package wordchainsresolver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FilterRule decides if a word is kept in the searchable vocabulary
+type FilterRule interface {
+	Name() string
+	Keep(string) bool
+}
+
+type filterRule struct {
+	name string
+	keep func(string) bool
+}
+
+func (rule *filterRule) Name() string {
+	return rule.name
+}
+
+func (rule *filterRule) Keep(word string) bool {
+	return rule.keep(word)
+}
+
+// NewMinLengthRule create a FilterRule removing words shorter than length letters
+func NewMinLengthRule(length int) FilterRule {
+	return &filterRule{
+		name: "min-length " + strconv.Itoa(length),
+		keep: func(word string) bool {
+			return len([]rune(word)) >= length
+		},
+	}
+}
+
+// NewMaxLengthRule create a FilterRule removing words longer than length letters
+func NewMaxLengthRule(length int) FilterRule {
+	return &filterRule{
+		name: "max-length " + strconv.Itoa(length),
+		keep: func(word string) bool {
+			return len([]rune(word)) <= length
+		},
+	}
+}
+
+// NewAlphabetRule create a FilterRule removing words using a letter
+// which is not in alphabet
+func NewAlphabetRule(alphabet string) FilterRule {
+	return &filterRule{
+		name: "alphabet " + alphabet,
+		keep: func(word string) bool {
+			for _, char := range word {
+				if !strings.ContainsRune(alphabet, char) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// NewIncludePatternRule create a FilterRule removing words which do not match pattern
+func NewIncludePatternRule(pattern *regexp.Regexp) FilterRule {
+	return &filterRule{
+		name: "include " + pattern.String(),
+		keep: pattern.MatchString,
+	}
+}
+
+// NewExcludePatternRule create a FilterRule removing words which match pattern
+func NewExcludePatternRule(pattern *regexp.Regexp) FilterRule {
+	return &filterRule{
+		name: "exclude " + pattern.String(),
+		keep: func(word string) bool {
+			return !pattern.MatchString(word)
+		},
+	}
+}
+
+// NewBlocklistRule create a FilterRule removing the words loaded by factory
+func NewBlocklistRule(factory Factory) (FilterRule, error) {
+	blockedWords, err := factory.LoadDB()
+	if err != nil {
+		return nil, err
+	}
+	blockedWordSet := toWordSet(blockedWords)
+	return &filterRule{
+		name: "blocklist",
+		keep: func(word string) bool {
+			_, blocked := blockedWordSet[word]
+			return !blocked
+		},
+	}, nil
+}
+
+// FilterReport tells how many words a FilterRule removed
+type FilterReport struct {
+	Rule    string
+	Removed int
+}
+
+// FilteredFactory struct implements Factory interface by removing the words
+// of another Factory which are not kept by all its rules. A removed word
+// is counted for the first rule rejecting it
+type FilteredFactory struct {
+	factory Factory
+	rules   []FilterRule
+	report  []FilterReport
+}
+
+// NewFilteredFactory is a FilteredFactory constructor
+func NewFilteredFactory(factory Factory, rules ...FilterRule) *FilteredFactory {
+	return &FilteredFactory{factory: factory, rules: rules}
+}
+
+// LoadDB implements Factory interface
+func (filtered *FilteredFactory) LoadDB() ([]string, error) {
+	words, err := filtered.factory.LoadDB()
+	if err != nil {
+		return nil, err
+	}
+	report := make([]FilterReport, len(filtered.rules))
+	for index, rule := range filtered.rules {
+		report[index].Rule = rule.Name()
+	}
+	var wordList []string
+	for _, word := range words {
+		kept := true
+		for index, rule := range filtered.rules {
+			if !rule.Keep(word) {
+				report[index].Removed++
+				kept = false
+				break
+			}
+		}
+		if kept {
+			wordList = append(wordList, word)
+		}
+	}
+	filtered.report = report
+	return wordList, nil
+}
+
+// Report return how many words each rule removed during the last load
+func (filtered *FilteredFactory) Report() []FilterReport {
+	return filtered.report
+}
+
+// Normalize return the key a word is searched with, if the wrapped Factory normalizes words
+func (filtered *FilteredFactory) Normalize(word string) string {
+	if normalizingFactory, ok := filtered.factory.(NormalizingFactory); ok {
+		return normalizingFactory.Normalize(word)
+	}
+	return word
+}
+
+// DisplayForm return the original spelling of a key, if the wrapped Factory normalizes words
+func (filtered *FilteredFactory) DisplayForm(key string) string {
+	if normalizingFactory, ok := filtered.factory.(NormalizingFactory); ok {
+		return normalizingFactory.DisplayForm(key)
+	}
+	return key
+}
+
+// WordSource return the source of a word if the wrapped Factory is able to tell it
+func (filtered *FilteredFactory) WordSource(word string) (string, bool) {
+	if sourceFactory, ok := filtered.factory.(SourceFactory); ok {
+		return sourceFactory.WordSource(word)
+	}
+	return "", false
+}
@@ -0,0 +1,49 @@
+package wordchainsresolver
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilteredFactory_LoadDB(t *testing.T) {
+	blocklistRule, err := NewBlocklistRule(&MockListFactory{words: []string{"dot"}})
+	assert.Nil(t, err)
+	factory := NewFilteredFactory(
+		&MockListFactory{words: []string{"a", "cat", "cot", "cog", "dog", "dot", "code", "kat", "c4t", "parrot"}},
+		NewMinLengthRule(2),
+		NewMaxLengthRule(4),
+		NewAlphabetRule("abcdefghijklmnopqrstuvwxyz"),
+		NewIncludePatternRule(regexp.MustCompile("^[cdk]")),
+		NewExcludePatternRule(regexp.MustCompile("^k")),
+		blocklistRule,
+	)
+	wordList, err := factory.LoadDB()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cat", "cot", "cog", "dog", "code"}, wordList)
+	expectedReport := []FilterReport{
+		{Rule: "min-length 2", Removed: 1},
+		{Rule: "max-length 4", Removed: 1},
+		{Rule: "alphabet abcdefghijklmnopqrstuvwxyz", Removed: 1},
+		{Rule: "include ^[cdk]", Removed: 0},
+		{Rule: "exclude ^k", Removed: 1},
+		{Rule: "blocklist", Removed: 1},
+	}
+	assert.Equal(t, expectedReport, factory.Report())
+
+	_, err = NewBlocklistRule(&MockBadFactory{})
+	assert.NotNil(t, err)
+	_, err = NewFilteredFactory(&MockBadFactory{}).LoadDB()
+	assert.NotNil(t, err)
+}
+
+func TestFilteredFactory_Normalization(t *testing.T) {
+	normalized := NewNormalizedFactory(&MockListFactory{words: []string{"Cat", "Cot"}}, NewDefaultNormalizer())
+	factory := NewFilteredFactory(normalized, NewExcludePatternRule(regexp.MustCompile("o")))
+	wcr := NewWordChainsResolver(&MockSolver{}, factory)
+	assert.Nil(t, wcr.LoadDB())
+	assert.True(t, wcr.IsWordInDB("CAT"))
+	assert.False(t, wcr.IsWordInDB("cot"))
+	assert.Equal(t, [][]string{{"Cat"}}, wcr.DisplayChains([][]string{{"cat"}}))
+}
@@ -0,0 +1,123 @@
+package wordchainsresolver
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrorMalformedFrequencyLine is trigger when a frequency list line is not
+// in the form word<TAB>count
+var ErrorMalformedFrequencyLine = errors.New("frequency : malformed line, expected word<TAB>count")
+
+// FrequencyList holds how often words are used, missing words have a zero frequency
+type FrequencyList map[string]int
+
+// LoadFrequencyList reads a file containing a word and its count per line,
+// separated by a tab. If normalizer is not nil, words are normalized and
+// counts of words sharing a key are summed
+func LoadFrequencyList(path string, normalizer *Normalizer) (FrequencyList, error) {
+	frequencies := make(FrequencyList)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 2 {
+			return nil, ErrorMalformedFrequencyLine
+		}
+		count, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, ErrorMalformedFrequencyLine
+		}
+		word := fields[0]
+		if normalizer != nil {
+			word = normalizer.Normalize(word)
+		}
+		frequencies[word] += count
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return frequencies, nil
+}
+
+// Commonness return the frequency of the rarest intermediate word of a chain.
+// A chain without intermediate word is as common as possible
+func (frequencies FrequencyList) Commonness(chain []string) int {
+	commonness := int(^uint(0) >> 1)
+	for index := 1; index < len(chain)-1; index++ {
+		if frequencies[chain[index]] < commonness {
+			commonness = frequencies[chain[index]]
+		}
+	}
+	return commonness
+}
+
+func (frequencies FrequencyList) totalFrequency(chain []string) int {
+	total := 0
+	for index := 1; index < len(chain)-1; index++ {
+		total += frequencies[chain[index]]
+	}
+	return total
+}
+
+// RankChains sorts word chains, shorter chains first. Equally short chains are
+// ordered by commonness then by the total frequency of their intermediate words
+func (frequencies FrequencyList) RankChains(chains [][]string) [][]string {
+	rankedChains := make([][]string, len(chains))
+	copy(rankedChains, chains)
+	sort.SliceStable(rankedChains, func(i, j int) bool {
+		if len(rankedChains[i]) != len(rankedChains[j]) {
+			return len(rankedChains[i]) < len(rankedChains[j])
+		}
+		commonnessI, commonnessJ := frequencies.Commonness(rankedChains[i]), frequencies.Commonness(rankedChains[j])
+		if commonnessI != commonnessJ {
+			return commonnessI > commonnessJ
+		}
+		return frequencies.totalFrequency(rankedChains[i]) > frequencies.totalFrequency(rankedChains[j])
+	})
+	return rankedChains
+}
+
+// excludeRareWords return the words of wordList used at least minFrequency
+// times, words in keptWords are always returned
+func (frequencies FrequencyList) excludeRareWords(wordList []string, minFrequency int, keptWords ...string) []string {
+	var commonWords []string
+	for _, word := range wordList {
+		if frequencies[word] >= minFrequency || isWordInList(word, keptWords) {
+			commonWords = append(commonWords, word)
+		}
+	}
+	return commonWords
+}
+
+// rareWords tells, for every word of graph, if it is too rare to be used by
+// the solutions of wcr. Words in keptWords are never rare. It return nil when
+// no minimum frequency is set
+func (wcr *WordChainsResolver) rareWords(graph *WordGraph, keptWords ...string) []bool {
+	if wcr.frequencies == nil || wcr.minFrequency <= 0 {
+		return nil
+	}
+	rare := make([]bool, len(graph.words))
+	for index, word := range graph.words {
+		rare[index] = wcr.isRareWord(word) && !isWordInList(word, keptWords)
+	}
+	return rare
+}
+
+// isRareWord tells if word is too rare to be used by the solutions of wcr
+func (wcr *WordChainsResolver) isRareWord(word string) bool {
+	return wcr.frequencies != nil && wcr.minFrequency > 0 && wcr.frequencies[word] < wcr.minFrequency
+}
@@ -0,0 +1,72 @@
+package wordchainsresolver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTemporaryFile(t *testing.T, content string) string {
+	directory, err := ioutil.TempDir("", "wordchains")
+	assert.Nil(t, err)
+	t.Cleanup(func() { os.RemoveAll(directory) })
+	path := filepath.Join(directory, "file.txt")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoadFrequencyList(t *testing.T) {
+	path := writeTemporaryFile(t, "cat\t100\nCat\t20\n\ndog\t 7\n")
+	frequencies, err := LoadFrequencyList(path, NewDefaultNormalizer())
+	assert.Nil(t, err)
+	assert.Equal(t, FrequencyList{"cat": 120, "dog": 7}, frequencies)
+
+	frequencies, err = LoadFrequencyList(path, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, FrequencyList{"cat": 100, "Cat": 20, "dog": 7}, frequencies)
+
+	_, err = LoadFrequencyList(writeTemporaryFile(t, "cat 100\n"), nil)
+	assert.Equal(t, ErrorMalformedFrequencyLine, err)
+	_, err = LoadFrequencyList(writeTemporaryFile(t, "cat\tmany\n"), nil)
+	assert.Equal(t, ErrorMalformedFrequencyLine, err)
+	_, err = LoadFrequencyList("/badpath/thing.txt", nil)
+	assert.NotNil(t, err)
+}
+
+func TestFrequencyList_RankChains(t *testing.T) {
+	frequencies := FrequencyList{"cot": 50, "cog": 5, "dot": 40}
+	chains := [][]string{
+		{"cat", "cot", "cog", "dog"},
+		{"cat", "cat", "dog"},
+		{"cat", "cot", "dot", "dog"},
+		{"cat", "cut", "dot", "dog"},
+	}
+	expected := [][]string{
+		{"cat", "cat", "dog"},
+		{"cat", "cot", "dot", "dog"},
+		{"cat", "cot", "cog", "dog"},
+		{"cat", "cut", "dot", "dog"},
+	}
+	assert.Equal(t, expected, frequencies.RankChains(chains))
+	assert.Equal(t, 5, frequencies.Commonness(chains[0]))
+	assert.Equal(t, int(^uint(0)>>1), frequencies.Commonness([]string{"cat", "cot"}))
+}
+
+func TestWordChainsResolver_UseFrequencies(t *testing.T) {
+	wcr := NewWordChainsResolver(NewBFSSolver(), &MockListFactory{words: []string{"cat", "cot", "cog", "dog", "dot"}})
+	assert.Nil(t, wcr.LoadDB())
+	frequencies := FrequencyList{"cot": 50, "cog": 5, "dot": 40}
+
+	wcr.UseFrequencies(frequencies, 0)
+	result, err := wcr.Solve("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "dot", "dog"}, {"cat", "cot", "cog", "dog"}}, result)
+
+	wcr.UseFrequencies(frequencies, 10)
+	result, err = wcr.Solve("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "dot", "dog"}}, result)
+}
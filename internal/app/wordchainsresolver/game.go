@@ -0,0 +1,159 @@
+package wordchainsresolver
+
+import "errors"
+
+var (
+	// ErrorNoWordChain is trigger when a game is created between two words
+	// which can not be chained
+	ErrorNoWordChain = errors.New("game : no word chain between these words")
+
+	// ErrorNotOneLetterChange is trigger when a played word does not differ by
+	// exactly one letter from the previous word
+	ErrorNotOneLetterChange = errors.New("game : word must differ by exactly one letter from the previous word")
+
+	// ErrorWordAlreadyPlayed is trigger when a word is played twice in a chain
+	ErrorWordAlreadyPlayed = errors.New("game : word already played")
+
+	// ErrorRareWord is trigger when a played word is used less often than the
+	// minimum frequency of the resolver
+	ErrorRareWord = errors.New("game : word is too rare")
+
+	// ErrorNothingToUndo is trigger when undoing while no word was played
+	ErrorNothingToUndo = errors.New("game : nothing to undo")
+
+	// ErrorGameOver is trigger when playing or undoing once the last word is reached
+	ErrorGameOver = errors.New("game : game is over")
+)
+
+// undoPenalty is the number of points lost per undo
+const undoPenalty = 5
+
+// Game is a word ladder played one word per turn, from a word to another
+type Game struct {
+	wcr          *WordChainsResolver
+	chain        []string
+	to           string
+	optimalSteps int
+	undos        int
+}
+
+// NewGame create a Game between two words of the loaded database. The optimal
+// number of steps is given by the resolver's Solver
+func (wcr *WordChainsResolver) NewGame(from, to string) (*Game, error) {
+	solutions, err := wcr.Solve(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(solutions) == 0 {
+		return nil, ErrorNoWordChain
+	}
+	return &Game{
+		wcr:          wcr,
+		chain:        []string{wcr.Normalize(from)},
+		to:           wcr.Normalize(to),
+		optimalSteps: len(solutions[0]) - 1,
+	}, nil
+}
+
+// Play adds a word to the chain. It must be in the loaded database, differ by
+// exactly one letter from the previous word and, but for the last word, not be
+// too rare to be used by the optimal word chains
+func (game *Game) Play(word string) error {
+	if game.IsOver() {
+		return ErrorGameOver
+	}
+	word = game.wcr.Normalize(word)
+	if !game.wcr.IsWordInDB(word) {
+		return ErrorWordNotFoundInDB
+	}
+	if !isPossibleNextWord(word, game.Current()) {
+		return ErrorNotOneLetterChange
+	}
+	if isWordInList(word, game.chain) {
+		return ErrorWordAlreadyPlayed
+	}
+	if word != game.to && game.wcr.isRareWord(word) {
+		return ErrorRareWord
+	}
+	game.chain = append(game.chain, word)
+	return nil
+}
+
+// Undo removes the last played word, each undo costs points
+func (game *Game) Undo() error {
+	if game.IsOver() {
+		return ErrorGameOver
+	}
+	if len(game.chain) == 1 {
+		return ErrorNothingToUndo
+	}
+	game.chain = game.chain[:len(game.chain)-1]
+	game.undos++
+	return nil
+}
+
+// Chain return the words played so far, starting with the first word
+func (game *Game) Chain() []string {
+	return game.chain
+}
+
+// Current return the last played word
+func (game *Game) Current() string {
+	return game.chain[len(game.chain)-1]
+}
+
+// Target return the word to reach
+func (game *Game) Target() string {
+	return game.to
+}
+
+// Steps return the number of words played so far
+func (game *Game) Steps() int {
+	return len(game.chain) - 1
+}
+
+// OptimalSteps return the number of steps of the shortest word chains
+func (game *Game) OptimalSteps() int {
+	return game.optimalSteps
+}
+
+// Undos return the number of undone words
+func (game *Game) Undos() int {
+	return game.undos
+}
+
+// IsOver check if the last word was reached
+func (game *Game) IsOver() bool {
+	return game.Current() == game.to
+}
+
+// Score return a score out of 100 once the game is over : an optimal chain
+// without undo is worth 100 points, longer chains are worth proportionally
+// less and each undo costs 5 points
+func (game *Game) Score() int {
+	if !game.IsOver() {
+		return 0
+	}
+	if game.Steps() == 0 {
+		return 100
+	}
+	score := 100*game.optimalSteps/game.Steps() - undoPenalty*game.undos
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
+
+// Hint return the words following the last played word on a shortest word chain
+// to the last word. Words already played are not hinted, so no hint means the
+// player should undo
+func (game *Game) Hint() ([]string, error) {
+	hints, err := game.wcr.Hint(game.Current(), game.to)
+	if err != nil {
+		return nil, err
+	}
+	return excludeStringsFromStrings(hints, game.chain), nil
+}
@@ -0,0 +1,84 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockGameResolver(t *testing.T) *WordChainsResolver {
+	wcr := NewWordChainsResolver(NewBFSSolver(), &MockListFactory{words: []string{"cat", "cot", "cog", "dog", "dot", "cut", "ebb"}})
+	assert.Nil(t, wcr.LoadDB())
+	return wcr
+}
+
+func TestWordChainsResolver_NewGame(t *testing.T) {
+	wcr := newMockGameResolver(t)
+	game, err := wcr.NewGame("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, game.OptimalSteps())
+	assert.Equal(t, []string{"cat"}, game.Chain())
+	assert.Equal(t, "dog", game.Target())
+	assert.False(t, game.IsOver())
+
+	_, err = wcr.NewGame("cat", "ebb")
+	assert.Equal(t, ErrorNoWordChain, err)
+	_, err = wcr.NewGame("cat", "www")
+	assert.Equal(t, ErrorWordNotFoundInDB, err)
+}
+
+func TestGame_Play(t *testing.T) {
+	game, err := newMockGameResolver(t).NewGame("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, ErrorNothingToUndo, game.Undo())
+	assert.Equal(t, ErrorWordNotFoundInDB, game.Play("cab"))
+	assert.Equal(t, ErrorNotOneLetterChange, game.Play("dot"))
+	assert.Nil(t, game.Play("cut"))
+	assert.Equal(t, ErrorWordAlreadyPlayed, game.Play("cat"))
+	assert.Equal(t, 0, game.Score())
+	assert.Nil(t, game.Undo())
+	assert.Nil(t, game.Play("cot"))
+	assert.Nil(t, game.Play("dot"))
+	assert.Nil(t, game.Play("dog"))
+
+	assert.True(t, game.IsOver())
+	assert.Equal(t, []string{"cat", "cot", "dot", "dog"}, game.Chain())
+	assert.Equal(t, 3, game.Steps())
+	assert.Equal(t, 1, game.Undos())
+	assert.Equal(t, 95, game.Score())
+	assert.Equal(t, ErrorGameOver, game.Play("dot"))
+	assert.Equal(t, ErrorGameOver, game.Undo())
+}
+
+func TestGame_Score(t *testing.T) {
+	game, err := newMockGameResolver(t).NewGame("cat", "dog")
+	assert.Nil(t, err)
+	for _, word := range []string{"cut", "cot", "cog", "dog"} {
+		assert.Nil(t, game.Play(word))
+	}
+	assert.Equal(t, 75, game.Score())
+
+	game, err = newMockGameResolver(t).NewGame("cat", "cat")
+	assert.Nil(t, err)
+	assert.True(t, game.IsOver())
+	assert.Equal(t, 100, game.Score())
+}
+
+func TestGame_PlayRareWord(t *testing.T) {
+	wcr := newMockGameResolver(t)
+	wcr.UseFrequencies(FrequencyList{"cot": 10, "cog": 10}, 5)
+	game, err := wcr.NewGame("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, game.OptimalSteps())
+	assert.Equal(t, ErrorRareWord, game.Play("cut"))
+	assert.Nil(t, game.Play("cot"))
+	assert.Equal(t, ErrorRareWord, game.Play("dot"))
+	assert.Nil(t, game.Play("cog"))
+	// the last word is played even if it is rare
+	assert.Nil(t, game.Play("dog"))
+	assert.Equal(t, 100, game.Score())
+
+	// a chain shorter than the optimal one is still worth 100 points
+	game.optimalSteps = 4
+	assert.Equal(t, 100, game.Score())
+}
@@ -0,0 +1,150 @@
+package wordchainsresolver
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrorUnknownGraphFormat is trigger when a graph export format name is unknown
+var ErrorUnknownGraphFormat = errors.New("graph export : unknown format")
+
+// GraphFormat is a file format a WordGraph can be exported to
+type GraphFormat string
+
+const (
+	// DOTFormat is the Graphviz format
+	DOTFormat GraphFormat = "dot"
+	// GraphMLFormat is the GraphML XML format
+	GraphMLFormat GraphFormat = "graphml"
+	// GEXFFormat is the Gephi XML format
+	GEXFFormat GraphFormat = "gexf"
+)
+
+// ParseGraphFormat return the graph format named dot, graphml or gexf
+func ParseGraphFormat(name string) (GraphFormat, error) {
+	switch format := GraphFormat(strings.ToLower(name)); format {
+	case DOTFormat, GraphMLFormat, GEXFFormat:
+		return format, nil
+	}
+	return "", ErrorUnknownGraphFormat
+}
+
+// exportedGraph is the part of a WordGraph made of the exported words
+type exportedGraph struct {
+	graph   *WordGraph
+	indexes []int
+	edges   [][2]int
+}
+
+func newExportedGraph(graph *WordGraph, words []string) *exportedGraph {
+	exported := &exportedGraph{graph: graph}
+	selected := make(map[int]interface{}, len(words))
+	for _, word := range words {
+		index, ok := graph.indexes[word]
+		if !ok {
+			continue
+		}
+		if _, ok := selected[index]; ok {
+			continue
+		}
+		selected[index] = nil
+		exported.indexes = append(exported.indexes, index)
+	}
+	for _, index := range exported.indexes {
+		for _, neighbor := range graph.neighbors[index] {
+			if _, ok := selected[neighbor]; ok && neighbor > index {
+				exported.edges = append(exported.edges, [2]int{index, neighbor})
+			}
+		}
+	}
+	return exported
+}
+
+// ExportGraph writes the words of a WordGraph and the links between them in a
+// graph format. Words carry their degree and component ID in the whole graph.
+// Words which are not in the graph are ignored
+func ExportGraph(w io.Writer, graph *WordGraph, words []string, format GraphFormat) error {
+	exported := newExportedGraph(graph, words)
+	writer := bufio.NewWriter(w)
+	switch format {
+	case DOTFormat:
+		exported.writeDOT(writer)
+	case GraphMLFormat:
+		exported.writeGraphML(writer)
+	case GEXFFormat:
+		exported.writeGEXF(writer)
+	default:
+		return ErrorUnknownGraphFormat
+	}
+	return writer.Flush()
+}
+
+func (exported *exportedGraph) writeDOT(writer *bufio.Writer) {
+	fmt.Fprintln(writer, "graph words {")
+	for _, index := range exported.indexes {
+		fmt.Fprintf(writer, "  %s [degree=%d, component=%d];\n", dotQuote(exported.graph.words[index]),
+			len(exported.graph.neighbors[index]), exported.graph.components[index])
+	}
+	for _, edge := range exported.edges {
+		fmt.Fprintf(writer, "  %s -- %s;\n", dotQuote(exported.graph.words[edge[0]]), dotQuote(exported.graph.words[edge[1]]))
+	}
+	fmt.Fprintln(writer, "}")
+}
+
+func (exported *exportedGraph) writeGraphML(writer *bufio.Writer) {
+	fmt.Fprintln(writer, `<?xml version="1.0" encoding="UTF-8"?>`)
+	fmt.Fprintln(writer, `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	fmt.Fprintln(writer, `  <key id="degree" for="node" attr.name="degree" attr.type="int"/>`)
+	fmt.Fprintln(writer, `  <key id="component" for="node" attr.name="component" attr.type="int"/>`)
+	fmt.Fprintln(writer, `  <graph id="words" edgedefault="undirected">`)
+	for _, index := range exported.indexes {
+		fmt.Fprintf(writer, "    <node id=\"%s\"><data key=\"degree\">%d</data><data key=\"component\">%d</data></node>\n",
+			xmlEscape(exported.graph.words[index]), len(exported.graph.neighbors[index]), exported.graph.components[index])
+	}
+	for _, edge := range exported.edges {
+		fmt.Fprintf(writer, "    <edge source=\"%s\" target=\"%s\"/>\n",
+			xmlEscape(exported.graph.words[edge[0]]), xmlEscape(exported.graph.words[edge[1]]))
+	}
+	fmt.Fprintln(writer, `  </graph>`)
+	fmt.Fprintln(writer, `</graphml>`)
+}
+
+func (exported *exportedGraph) writeGEXF(writer *bufio.Writer) {
+	fmt.Fprintln(writer, `<?xml version="1.0" encoding="UTF-8"?>`)
+	fmt.Fprintln(writer, `<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">`)
+	fmt.Fprintln(writer, `  <graph mode="static" defaultedgetype="undirected">`)
+	fmt.Fprintln(writer, `    <attributes class="node">`)
+	fmt.Fprintln(writer, `      <attribute id="degree" title="degree" type="integer"/>`)
+	fmt.Fprintln(writer, `      <attribute id="component" title="component" type="integer"/>`)
+	fmt.Fprintln(writer, `    </attributes>`)
+	fmt.Fprintln(writer, `    <nodes>`)
+	for _, index := range exported.indexes {
+		word := xmlEscape(exported.graph.words[index])
+		fmt.Fprintf(writer, "      <node id=\"%s\" label=\"%s\"><attvalues><attvalue for=\"degree\" value=\"%d\"/><attvalue for=\"component\" value=\"%d\"/></attvalues></node>\n",
+			word, word, len(exported.graph.neighbors[index]), exported.graph.components[index])
+	}
+	fmt.Fprintln(writer, `    </nodes>`)
+	fmt.Fprintln(writer, `    <edges>`)
+	for edgeID, edge := range exported.edges {
+		fmt.Fprintf(writer, "      <edge id=\"%d\" source=\"%s\" target=\"%s\"/>\n",
+			edgeID, xmlEscape(exported.graph.words[edge[0]]), xmlEscape(exported.graph.words[edge[1]]))
+	}
+	fmt.Fprintln(writer, `    </edges>`)
+	fmt.Fprintln(writer, `  </graph>`)
+	fmt.Fprintln(writer, `</gexf>`)
+}
+
+func dotQuote(word string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(word) + `"`
+}
+
+func xmlEscape(word string) string {
+	var builder strings.Builder
+	// writing to a strings.Builder never fails
+	_ = xml.EscapeText(&builder, []byte(word))
+	return builder.String()
+}
@@ -0,0 +1,62 @@
+package wordchainsresolver
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGraphFormat(t *testing.T) {
+	format, err := ParseGraphFormat("GraphML")
+	assert.Nil(t, err)
+	assert.Equal(t, GraphMLFormat, format)
+	_, err = ParseGraphFormat("svg")
+	assert.Equal(t, ErrorUnknownGraphFormat, err)
+}
+
+func TestExportGraph_DOT(t *testing.T) {
+	graph := NewWordGraph([]string{"cat", "cot", "cog", "dog", "a\"b"})
+	buffer := &bytes.Buffer{}
+	err := ExportGraph(buffer, graph, []string{"cat", "cot", "dog", "cut", "a\"b"}, DOTFormat)
+	assert.Nil(t, err)
+	expected := `graph words {
+  "cat" [degree=1, component=0];
+  "cot" [degree=2, component=0];
+  "dog" [degree=1, component=0];
+  "a\"b" [degree=0, component=1];
+  "cat" -- "cot";
+}
+`
+	assert.Equal(t, expected, buffer.String())
+}
+
+func TestExportGraph_XML(t *testing.T) {
+	graph := NewWordGraph([]string{"cat", "cot", "cog", "<&>"})
+	for _, format := range []GraphFormat{GraphMLFormat, GEXFFormat} {
+		buffer := &bytes.Buffer{}
+		err := ExportGraph(buffer, graph, graph.Words(), format)
+		assert.Nil(t, err)
+		decoder := xml.NewDecoder(buffer)
+		nodes, edges := 0, 0
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				break
+			}
+			if element, ok := token.(xml.StartElement); ok {
+				switch element.Name.Local {
+				case "node":
+					nodes++
+				case "edge":
+					edges++
+				}
+			}
+		}
+		assert.Equal(t, 4, nodes, format)
+		assert.Equal(t, 2, edges, format)
+	}
+	err := ExportGraph(&bytes.Buffer{}, graph, graph.Words(), GraphFormat("svg"))
+	assert.Equal(t, ErrorUnknownGraphFormat, err)
+}
@@ -0,0 +1,77 @@
+package wordchainsresolver
+
+import "sort"
+
+// WordDegree associates a word with its number of neighbors
+type WordDegree struct {
+	Word   string
+	Degree int
+}
+
+// LengthStats describes the part of a WordGraph made of words of the same length
+type LengthStats struct {
+	Length             int
+	Words              int
+	Edges              int
+	Components         int
+	LargestComponent   int
+	DegreeDistribution map[int]int
+	IsolatedWords      []string
+	Hubs               []WordDegree
+}
+
+// ComputeGraphStats return statistics of a WordGraph per word length, sorted by
+// length. Hubs are the hubCount words with the highest degree of each length
+func ComputeGraphStats(graph *WordGraph, hubCount int) []LengthStats {
+	statsByLength := make(map[int]*LengthStats)
+	componentsByLength := make(map[int]map[int]interface{})
+	wordDegrees := make(map[int][]WordDegree)
+	for index, word := range graph.words {
+		wordLength := len([]rune(word))
+		stats, ok := statsByLength[wordLength]
+		if !ok {
+			stats = &LengthStats{Length: wordLength, DegreeDistribution: make(map[int]int)}
+			statsByLength[wordLength] = stats
+			componentsByLength[wordLength] = make(map[int]interface{})
+		}
+		degree := len(graph.neighbors[index])
+		stats.Words++
+		stats.Edges += degree
+		stats.DegreeDistribution[degree]++
+		if degree == 0 {
+			stats.IsolatedWords = append(stats.IsolatedWords, word)
+		}
+		componentID := graph.components[index]
+		componentsByLength[wordLength][componentID] = nil
+		if graph.componentSizes[componentID] > stats.LargestComponent {
+			stats.LargestComponent = graph.componentSizes[componentID]
+		}
+		wordDegrees[wordLength] = append(wordDegrees[wordLength], WordDegree{Word: word, Degree: degree})
+	}
+
+	var graphStats []LengthStats
+	for wordLength, stats := range statsByLength {
+		// every edge was counted from both of its words
+		stats.Edges /= 2
+		stats.Components = len(componentsByLength[wordLength])
+		stats.Hubs = highestDegrees(wordDegrees[wordLength], hubCount)
+		graphStats = append(graphStats, *stats)
+	}
+	sort.Slice(graphStats, func(i, j int) bool {
+		return graphStats[i].Length < graphStats[j].Length
+	})
+	return graphStats
+}
+
+func highestDegrees(wordDegrees []WordDegree, count int) []WordDegree {
+	sort.SliceStable(wordDegrees, func(i, j int) bool {
+		return wordDegrees[i].Degree > wordDegrees[j].Degree
+	})
+	if count > len(wordDegrees) {
+		count = len(wordDegrees)
+	}
+	if count <= 0 {
+		return nil
+	}
+	return wordDegrees[:count]
+}
@@ -0,0 +1,50 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeGraphStats(t *testing.T) {
+	graph := NewWordGraph([]string{"cat", "cot", "cog", "dog", "dot", "ebb", "code", "cove", "love", "tree"})
+	expected := []LengthStats{
+		{
+			Length:             3,
+			Words:              6,
+			Edges:              5,
+			Components:         2,
+			LargestComponent:   5,
+			DegreeDistribution: map[int]int{0: 1, 1: 1, 2: 3, 3: 1},
+			IsolatedWords:      []string{"ebb"},
+			Hubs:               []WordDegree{{Word: "cot", Degree: 3}, {Word: "cog", Degree: 2}},
+		},
+		{
+			Length:             4,
+			Words:              4,
+			Edges:              2,
+			Components:         2,
+			LargestComponent:   3,
+			DegreeDistribution: map[int]int{0: 1, 1: 2, 2: 1},
+			IsolatedWords:      []string{"tree"},
+			Hubs:               []WordDegree{{Word: "cove", Degree: 2}, {Word: "code", Degree: 1}},
+		},
+	}
+	assert.Equal(t, expected, ComputeGraphStats(graph, 2))
+
+	stats := ComputeGraphStats(graph, 0)
+	assert.Nil(t, stats[0].Hubs)
+	stats = ComputeGraphStats(graph, 10)
+	assert.Equal(t, 6, len(stats[0].Hubs))
+}
+
+func TestWordChainsResolver_Stats(t *testing.T) {
+	wcr := NewWordChainsResolver(&MockSolver{}, &MockFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	stats := wcr.Stats(1)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, 4, stats[0].Words)
+	assert.Equal(t, 3, stats[0].Edges)
+	assert.Equal(t, []WordDegree{{Word: "cot", Degree: 2}}, stats[0].Hubs)
+	assert.Equal(t, wcr.Graph(), wcr.Graph())
+}
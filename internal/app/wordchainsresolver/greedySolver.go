@@ -1,5 +1,7 @@
 package wordchainsresolver
 
+import "context"
+
 // GreedyWordTreeNode struct represents words tidy in a tree
 type GreedyWordTreeNode struct {
 	Word            string
@@ -40,6 +42,40 @@ func (node *GreedyWordTreeNode) getNodeDepth() int {
 	return depth
 }
 
+func (node *GreedyWordTreeNode) searchTree(onSolution map[*GreedyWordTreeNode]interface{}) *SearchTreeNode {
+	_, ok := onSolution[node]
+	treeNode := &SearchTreeNode{Word: node.Word, OnSolution: ok}
+	for _, child := range node.NextElements {
+		treeNode.Children = append(treeNode.Children, child.searchTree(onSolution))
+	}
+	return treeNode
+}
+
+// GreedyOptions tunes how far the greedy solver explores
+type GreedyOptions struct {
+	// MaxDepth is the depth from which lower scores are not tried anymore,
+	// zero means three times the word length
+	MaxDepth int
+	// ScoreTolerance is the number of lower score levels tried when no word
+	// improves the score
+	ScoreTolerance int
+	// MaxBacktracks is the number of times a branch without solution makes
+	// its parent try the next lower score level
+	MaxBacktracks int
+	// NodeBudget is the maximum number of nodes created per search, zero means no limit
+	NodeBudget int
+}
+
+// DefaultGreedyOptions return the options used by NewGreedySolver
+func DefaultGreedyOptions() GreedyOptions {
+	return GreedyOptions{
+		MaxDepth:       0,
+		ScoreTolerance: 1,
+		MaxBacktracks:  0,
+		NodeBudget:     0,
+	}
+}
+
 // GreedySolver is a implementation of Solver interface in order to find
 // word chains with a greedy algorithm
 type GreedySolver struct {
@@ -51,12 +87,28 @@ type GreedySolver struct {
 	matchingWordNode     []*GreedyWordTreeNode
 	solutionFoundAtDepth int
 	maxDepth             int
+	options              GreedyOptions
+	backtracks           int
+	createdNodes         int
+	recordTree           bool
+	observer             SearchObserver
+	constraint           *ChainConstraint
+	moves                MoveGenerator
+	heuristic            Heuristic
+	ctx                  context.Context
+	searchTree           *SearchTreeNode
 }
 
 // NewGreedySolver is a simple GreedySolver constructor
 func NewGreedySolver() *GreedySolver {
+	return NewGreedySolverWithOptions(DefaultGreedyOptions())
+}
+
+// NewGreedySolverWithOptions is a GreedySolver constructor with exploration options
+func NewGreedySolverWithOptions(options GreedyOptions) *GreedySolver {
 	return &GreedySolver{
 		solutionFoundAtDepth: int(^uint(0) >> 1),
+		options:              options,
 	}
 }
 
@@ -73,30 +125,44 @@ func NewGreedySolverWithParams(from string, to string, wordList []string) *Greed
 		wordTree:             nil,
 		matchingWordNode:     nil,
 		solutionFoundAtDepth: int(^uint(0) >> 1),
-		maxDepth:             len(from) * 3,
+		maxDepth:             len([]rune(from)) * 3,
+		options:              DefaultGreedyOptions(),
 	}
 }
 
 // FindWordChains implements the Solver interface. The greedy solver generate a word chain
 // using the greedy algorithm. It is not complete so it may not give any expected
 func (greedy *GreedySolver) FindWordChains(from string, to string, wordList []string) ([][]string, error) {
-	if len([]rune(from)) != len([]rune(to)) {
+	if preservesLength(greedy.moves) && len([]rune(from)) != len([]rune(to)) {
 		return nil, ErrorWordLengthDoesNotMatch
 	}
+	greedy.searchTree = nil
 	greedy.from = from
 	greedy.to = to
 	greedy.wordList = wordList
-	greedy.maxDepth = len(from) * 3
+	greedy.maxDepth = greedy.options.MaxDepth
+	if greedy.maxDepth == 0 {
+		greedy.maxDepth = len([]rune(from)) * 3
+	}
 
 	greedy.getUsefulWordOnly()
 
 	solutions := greedy.getPath()
+	if err := contextError(greedy.ctx); err != nil {
+		greedy.Clean()
+		return nil, err
+	}
+	if greedy.recordTree {
+		greedy.searchTree = greedy.buildSearchTree()
+	}
 	greedy.Clean()
 	return getBestSolution(solutions), nil
 }
 
 func (greedy *GreedySolver) getPath() [][]string {
-	head := NewGreedyWordTreeElement(greedy.from, getScoreBetweenTwoWord(greedy.from, greedy.to), nil)
+	head := NewGreedyWordTreeElement(greedy.from, greedy.scoreFromGoal(greedy.from), nil)
+	greedy.notify(SearchStarted, head)
+	defer notify(greedy.observer, SearchEvent{Kind: SearchFinished, Solver: "greedy", Word: greedy.to})
 
 	var wordChainsList [][]string
 
@@ -110,36 +176,69 @@ func (greedy *GreedySolver) getPath() [][]string {
 }
 
 func (greedy *GreedySolver) generateTree(head *GreedyWordTreeNode, wordList []string) *GreedyWordTreeNode {
+	if contextError(greedy.ctx) != nil {
+		return head
+	}
 	// Ending condition
+	if head.Word == greedy.to && !greedy.constraint.changesEveryPosition(head.extractSolutionFromNode()) {
+		greedy.notify(NodePruned, head)
+		return head
+	}
 	if head.Word == greedy.to {
 		greedy.solutionFoundAtDepth = head.getNodeDepth()
 		greedy.matchingWordNode = append(greedy.matchingWordNode, head)
+		greedy.notify(SolutionFound, head)
 		return head
 	}
 	if head.getNodeDepth() > greedy.solutionFoundAtDepth {
+		greedy.notify(NodePruned, head)
 		return head
 	}
+	greedy.notify(NodeExpanded, head)
 
 	possibleNextWords := greedy.listPossibleNextWords(head.Word)
 	possibleNextWords = excludeStringsFromStrings(possibleNextWords, wordList)
-	numberOfChildAdded := 1
 	targetedScore := head.ScoreToGoal + 1
-	head, numberOfChildAdded = greedy.createPopulation(head, possibleNextWords, wordList, targetedScore)
 	depth := head.getNodeDepth()
-	if numberOfChildAdded == 0 && depth < greedy.maxDepth {
-		head, numberOfChildAdded = greedy.createPopulation(head, possibleNextWords, wordList, targetedScore-1)
+	// the best score level is tried first, lower levels are tried if it gives
+	// no child, or if it gives children without solution and backtracking is allowed
+	for level := 0; level <= greedy.options.ScoreTolerance; level++ {
+		if level > 0 && depth >= greedy.maxDepth {
+			break
+		}
+		solutionCount := len(greedy.matchingWordNode)
+		numberOfChildAdded := 0
+		head, numberOfChildAdded = greedy.createPopulation(head, possibleNextWords, wordList, targetedScore-level, level == 0)
+		if numberOfChildAdded == 0 {
+			continue
+		}
+		if len(greedy.matchingWordNode) > solutionCount || greedy.backtracks >= greedy.options.MaxBacktracks || greedy.isOverBudget() {
+			break
+		}
+		greedy.backtracks++
 	}
 
 	return head
 }
 
-func (greedy *GreedySolver) createPopulation(head *GreedyWordTreeNode, possibleNextWords, wordList []string, targetedScore int) (*GreedyWordTreeNode, int) {
+func (greedy *GreedySolver) isOverBudget() bool {
+	return greedy.options.NodeBudget > 0 && greedy.createdNodes >= greedy.options.NodeBudget
+}
+
+// createPopulation creates the children of head reaching targetedScore, or
+// a higher score if orBetter is true
+func (greedy *GreedySolver) createPopulation(head *GreedyWordTreeNode, possibleNextWords, wordList []string, targetedScore int, orBetter bool) (*GreedyWordTreeNode, int) {
 	numberOfNodeCreated := 0
 	for _, word := range possibleNextWords {
-		scoreFromGoal := getScoreBetweenTwoWord(word, greedy.to)
-		if scoreFromGoal == targetedScore {
+		scoreFromGoal := greedy.scoreFromGoal(word)
+		if scoreFromGoal == targetedScore || (orBetter && scoreFromGoal > targetedScore) {
+			if greedy.isOverBudget() {
+				break
+			}
+			greedy.createdNodes++
 			numberOfNodeCreated++
 			newNode := NewGreedyWordTreeElement(word, scoreFromGoal, head)
+			greedy.notify(NodeGenerated, newNode)
 			wordList = append(wordList, word)
 			newNode = greedy.generateTree(newNode, wordList)
 			head.NextElements = append(head.NextElements, newNode)
@@ -148,19 +247,107 @@ func (greedy *GreedySolver) createPopulation(head *GreedyWordTreeNode, possibleN
 	return head, numberOfNodeCreated
 }
 
-func (greedy *GreedySolver) getUsefulWordOnly() {
-	wordLength := len(greedy.from)
-	for _, word := range greedy.wordList {
-		if len(word) == wordLength && word != greedy.from {
-			greedy.usefulWords = append(greedy.usefulWords, word)
+// scoreFromGoal grows as word gets closer to the goal word, with the Hamming
+// heuristic it is the number of letters already matching the goal word
+func (greedy *GreedySolver) scoreFromGoal(word string) int {
+	if greedy.heuristic == nil {
+		return len([]rune(greedy.to)) - minMoves(greedy.moves, word, greedy.to)
+	}
+	return len([]rune(greedy.to)) - greedy.heuristic.Estimate(word, greedy.to)
+}
+
+// buildSearchTree highlights the shortest solutions only, as they are the returned ones
+func (greedy *GreedySolver) buildSearchTree() *SearchTreeNode {
+	onSolution := make(map[*GreedyWordTreeNode]interface{})
+	for _, node := range greedy.matchingWordNode {
+		if node.getNodeDepth() != greedy.solutionFoundAtDepth {
+			continue
+		}
+		for tmpNode := node; tmpNode != nil; tmpNode = tmpNode.PreviousElement {
+			onSolution[tmpNode] = nil
 		}
 	}
+	return greedy.wordTree.searchTree(onSolution)
+}
+
+// SetObserver implements the ObservableSolver interface
+func (greedy *GreedySolver) SetObserver(observer SearchObserver) {
+	greedy.observer = observer
+}
+
+func (greedy *GreedySolver) notify(kind SearchEventKind, node *GreedyWordTreeNode) {
+	if greedy.observer == nil {
+		return
+	}
+	event := SearchEvent{Kind: kind, Solver: "greedy", Word: node.Word, Depth: node.getNodeDepth() - 1}
+	if node.PreviousElement != nil {
+		event.Parent = node.PreviousElement.Word
+	}
+	if kind == SolutionFound {
+		event.Chain = node.extractSolutionFromNode()
+	}
+	notify(greedy.observer, event)
+}
+
+// SetChainConstraint implements the ConstrainedSolver interface
+func (greedy *GreedySolver) SetChainConstraint(constraint *ChainConstraint) {
+	greedy.constraint = constraint
+}
+
+// ChainConstraint implements the ConstrainedSolver interface
+func (greedy *GreedySolver) ChainConstraint() *ChainConstraint {
+	return greedy.constraint
+}
+
+// SetHeuristic implements the InformedSolver interface
+func (greedy *GreedySolver) SetHeuristic(heuristic Heuristic) {
+	greedy.heuristic = heuristic
+}
+
+// Heuristic implements the InformedSolver interface
+func (greedy *GreedySolver) Heuristic() Heuristic {
+	return greedy.heuristic
+}
+
+// SetMoveGenerator implements the MoveGeneratingSolver interface
+func (greedy *GreedySolver) SetMoveGenerator(moves MoveGenerator) {
+	greedy.moves = moves
+}
+
+// MoveGenerator implements the MoveGeneratingSolver interface
+func (greedy *GreedySolver) MoveGenerator() MoveGenerator {
+	return greedy.moves
+}
+
+// SetContext implements the CancellableSolver interface
+func (greedy *GreedySolver) SetContext(ctx context.Context) {
+	greedy.ctx = ctx
+}
+
+// Context implements the CancellableSolver interface
+func (greedy *GreedySolver) Context() context.Context {
+	return greedy.ctx
+}
+
+// RecordSearchTree implements the SearchTreeRecorder interface
+func (greedy *GreedySolver) RecordSearchTree(record bool) {
+	greedy.recordTree = record
+}
+
+// SearchTree implements the SearchTreeRecorder interface. It return the tree
+// explored by the last search, or nil if recording was disabled
+func (greedy *GreedySolver) SearchTree() *SearchTreeNode {
+	return greedy.searchTree
+}
+
+func (greedy *GreedySolver) getUsefulWordOnly() {
+	greedy.usefulWords = candidateWords(greedy.moves, greedy.from, greedy.wordList)
 }
 
 func (greedy *GreedySolver) listPossibleNextWords(word string) []string {
 	var possibleNewWords []string
 	for _, nextWord := range greedy.usefulWords {
-		if getScoreBetweenTwoWord(nextWord, word) == len(word)-1 {
+		if allowsMove(greedy.moves, greedy.constraint, word, nextWord, greedy.to) {
 			possibleNewWords = append(possibleNewWords, nextWord)
 		}
 	}
@@ -175,4 +362,6 @@ func (greedy *GreedySolver) Clean() {
 	greedy.wordTree = nil
 	greedy.matchingWordNode = nil
 	greedy.solutionFoundAtDepth = int(^uint(0) >> 1)
+	greedy.backtracks = 0
+	greedy.createdNodes = 0
 }
@@ -121,3 +121,77 @@ func ExampleGreedySolver_FindWordChains() {
 	// Output :
 	// [[cat cot cog dog] [cat cot dot dog]]
 }
+
+func TestGreedySolver_options(t *testing.T) {
+	wordList := []string{"aaa", "baa", "aca", "acb", "abb", "bbb"}
+	solver := NewGreedySolver()
+	result, err := solver.FindWordChains("aaa", "bbb", wordList)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result))
+
+	options := DefaultGreedyOptions()
+	options.MaxBacktracks = 1
+	solver = NewGreedySolverWithOptions(options)
+	result, err = solver.FindWordChains("aaa", "bbb", wordList)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"aaa", "aca", "acb", "abb", "bbb"}}, result)
+
+	options.MaxDepth = 1
+	solver = NewGreedySolverWithOptions(options)
+	result, err = solver.FindWordChains("aaa", "bbb", wordList)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result))
+}
+
+func TestGreedySolver_ScoreTolerance(t *testing.T) {
+	factory := NewFileLoaderFactory(os.Getenv("GOPATH") + "/src/github.com/clnbs/wordChains/assets/app/small_en.txt")
+	wordList, err := factory.LoadDB()
+	assert.Nil(t, err)
+
+	result, err := NewGreedySolver().FindWordChains("bar", "oil", wordList)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result))
+
+	options := DefaultGreedyOptions()
+	options.ScoreTolerance = 2
+	result, err = NewGreedySolverWithOptions(options).FindWordChains("bar", "oil", wordList)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(result))
+	for _, chain := range result {
+		assert.Equal(t, "bar", chain[0])
+		assert.Equal(t, "oil", chain[len(chain)-1])
+	}
+}
+
+func TestGreedySolver_NodeBudget(t *testing.T) {
+	options := DefaultGreedyOptions()
+	options.NodeBudget = 2
+	solver := NewGreedySolverWithOptions(options)
+	result, err := solver.FindWordChains("cat", "dog", []string{"cat", "cot", "cog", "dog", "dot"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result))
+
+	options.NodeBudget = 3
+	solver = NewGreedySolverWithOptions(options)
+	result, err = solver.FindWordChains("cat", "dog", []string{"cat", "cot", "cog", "dog", "dot"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+}
+
+func TestGreedySolver_SearchTree(t *testing.T) {
+	var _ SearchTreeRecorder = NewGreedySolver()
+	solver := NewGreedySolver()
+	solver.RecordSearchTree(true)
+	_, err := solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	tree := solver.SearchTree()
+	assert.Equal(t, "cat", tree.Word)
+	assert.True(t, tree.OnSolution)
+	assert.Equal(t, "cot", tree.Children[0].Word)
+	assert.True(t, tree.Children[0].OnSolution)
+
+	solver.RecordSearchTree(false)
+	_, err = solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Nil(t, solver.SearchTree())
+}
@@ -0,0 +1,140 @@
+package wordchainsresolver
+
+import "sort"
+
+// HardestPair is a pair of words whose shortest word chain is the longest of
+// their component
+type HardestPair struct {
+	From  string
+	To    string
+	Chain []string
+}
+
+// ComponentDiameter describes the longest shortest word chains of a connected
+// component of a WordGraph. Diameter is a number of steps. If Exact is false,
+// the diameter was searched from a limited number of candidate words and may be
+// underestimated
+type ComponentDiameter struct {
+	WordLength int
+	Component  int
+	Size       int
+	Diameter   int
+	Exact      bool
+	Pairs      []HardestPair
+}
+
+// HardestPairsOptions tunes the hardest pairs search
+type HardestPairsOptions struct {
+	// TopPairs is the maximum number of pairs reported per component
+	TopPairs int
+	// MinComponentSize ignores components having less words
+	MinComponentSize int
+	// ExactLimit is the maximum component size searched from every word
+	ExactLimit int
+	// CandidateLimit is the maximum number of walks in a bigger component
+	CandidateLimit int
+}
+
+// DefaultHardestPairsOptions return options searching en.txt within minutes
+func DefaultHardestPairsOptions() HardestPairsOptions {
+	return HardestPairsOptions{
+		TopPairs:         5,
+		MinComponentSize: 2,
+		ExactLimit:       1000,
+		CandidateLimit:   50,
+	}
+}
+
+type hardestPairsSearch struct {
+	walker    *graphWalker
+	options   HardestPairsOptions
+	diameter  *ComponentDiameter
+	pairsSeen map[[2]int]interface{}
+}
+
+// FindHardestPairs return the diameter and the eccentric pairs of every
+// component of a WordGraph, sorted by word length then by decreasing diameter.
+// Small components are searched from every word. In bigger components, the
+// search starts from a word and continues from the farthest words it reaches,
+// until no new farthest word is found or the candidate limit is reached
+func FindHardestPairs(graph *WordGraph, options HardestPairsOptions) []ComponentDiameter {
+	members := make([][]int, len(graph.componentSizes))
+	for index, componentID := range graph.components {
+		members[componentID] = append(members[componentID], index)
+	}
+	search := &hardestPairsSearch{walker: newGraphWalker(graph), options: options}
+	var diameters []ComponentDiameter
+	for componentID, componentMembers := range members {
+		if len(componentMembers) < options.MinComponentSize || len(componentMembers) < 2 {
+			continue
+		}
+		search.diameter = &ComponentDiameter{
+			WordLength: len([]rune(graph.words[componentMembers[0]])),
+			Component:  componentID,
+			Size:       len(componentMembers),
+			Exact:      len(componentMembers) <= options.ExactLimit,
+		}
+		search.pairsSeen = make(map[[2]int]interface{})
+		if search.diameter.Exact {
+			for _, source := range componentMembers {
+				search.walkFrom(source)
+			}
+		} else {
+			search.walkFromCandidates(componentMembers[0])
+		}
+		diameters = append(diameters, *search.diameter)
+	}
+	sort.SliceStable(diameters, func(i, j int) bool {
+		if diameters[i].WordLength != diameters[j].WordLength {
+			return diameters[i].WordLength < diameters[j].WordLength
+		}
+		return diameters[i].Diameter > diameters[j].Diameter
+	})
+	return diameters
+}
+
+func (search *hardestPairsSearch) walkFromCandidates(start int) {
+	candidates := []int{start}
+	walked := map[int]interface{}{start: nil}
+	for walks := 0; len(candidates) != 0 && walks < search.options.CandidateLimit; walks++ {
+		var candidate int
+		candidate, candidates = candidates[0], candidates[1:]
+		for _, farthestWord := range search.walkFrom(candidate) {
+			if _, ok := walked[farthestWord]; !ok {
+				walked[farthestWord] = nil
+				candidates = append(candidates, farthestWord)
+			}
+		}
+	}
+}
+
+// walkFrom registers the eccentric pairs of source and return its farthest words
+func (search *hardestPairsSearch) walkFrom(source int) []int {
+	search.walker.walk(source)
+	farthestWords := search.walker.farthest()
+	eccentricity := search.walker.distances[farthestWords[0]]
+	if eccentricity < search.diameter.Diameter {
+		return farthestWords
+	}
+	if eccentricity > search.diameter.Diameter {
+		search.diameter.Diameter = eccentricity
+		search.diameter.Pairs = nil
+		search.pairsSeen = make(map[[2]int]interface{})
+	}
+	for _, target := range farthestWords {
+		if len(search.diameter.Pairs) >= search.options.TopPairs {
+			break
+		}
+		pairKey := [2]int{source, target}
+		if target < source {
+			pairKey = [2]int{target, source}
+		}
+		if _, ok := search.pairsSeen[pairKey]; ok {
+			continue
+		}
+		search.pairsSeen[pairKey] = nil
+		chain := search.walker.chainTo(target)
+		search.diameter.Pairs = append(search.diameter.Pairs, HardestPair{From: chain[0], To: chain[len(chain)-1], Chain: chain})
+	}
+	return farthestWords
+}
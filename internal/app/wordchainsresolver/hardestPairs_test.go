@@ -0,0 +1,62 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindHardestPairs(t *testing.T) {
+	graph := NewWordGraph([]string{"cat", "cot", "cog", "dog", "dot", "ebb", "code", "cove", "love", "lose"})
+	options := DefaultHardestPairsOptions()
+	expected := []ComponentDiameter{
+		{
+			WordLength: 3,
+			Component:  0,
+			Size:       5,
+			Diameter:   3,
+			Exact:      true,
+			Pairs:      []HardestPair{{From: "cat", To: "dog", Chain: []string{"cat", "cot", "cog", "dog"}}},
+		},
+		{
+			WordLength: 4,
+			Component:  2,
+			Size:       4,
+			Diameter:   3,
+			Exact:      true,
+			Pairs:      []HardestPair{{From: "code", To: "lose", Chain: []string{"code", "cove", "love", "lose"}}},
+		},
+	}
+	assert.Equal(t, expected, FindHardestPairs(graph, options))
+
+	options.MinComponentSize = 5
+	assert.Equal(t, expected[:1], FindHardestPairs(graph, options))
+}
+
+func TestFindHardestPairs_candidates(t *testing.T) {
+	wordList := []string{"aaa", "baa", "bba", "bbb", "cbb", "ccb", "ccc", "acc", "aac", "aab", "dab"}
+	options := DefaultHardestPairsOptions()
+	exact := FindHardestPairs(NewWordGraph(wordList), options)
+	options.ExactLimit = 2
+	options.CandidateLimit = 10
+	candidates := FindHardestPairs(NewWordGraph(wordList), options)
+
+	assert.Equal(t, 1, len(candidates))
+	assert.False(t, candidates[0].Exact)
+	assert.Equal(t, exact[0].Diameter, candidates[0].Diameter)
+	for _, pair := range candidates[0].Pairs {
+		assert.Equal(t, candidates[0].Diameter+1, len(pair.Chain))
+	}
+
+	options.TopPairs = 1
+	candidates = FindHardestPairs(NewWordGraph(wordList), options)
+	assert.Equal(t, 1, len(candidates[0].Pairs))
+}
+
+func TestWordChainsResolver_HardestPairs(t *testing.T) {
+	wcr := NewWordChainsResolver(&MockSolver{}, &MockFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	diameters := wcr.HardestPairs(DefaultHardestPairsOptions())
+	assert.Equal(t, 1, len(diameters))
+	assert.Equal(t, []string{"cat", "cot", "cog", "dog"}, diameters[0].Pairs[0].Chain)
+}
@@ -0,0 +1,225 @@
+package wordchainsresolver
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrorUnknownHeuristic is trigger when a heuristic name is unknown
+var ErrorUnknownHeuristic = errors.New("heuristic : unknown heuristic")
+
+// ErrorHeuristicWithMoves is trigger when a solver guided by the hamming
+// heuristic searches with move rules other than one letter substitutions,
+// which it may overestimate
+var ErrorHeuristicWithMoves = errors.New("heuristic : hamming only guides one letter substitutions")
+
+// unreachableEstimate is the estimate of a goal word which can not be reached,
+// it is far above any chain length without overflowing when depths are added
+const unreachableEstimate = 1 << 30
+
+// Heuristic estimates the number of steps from a word to the goal word,
+// the lower the estimate is, the closer the word is
+type Heuristic interface {
+	Estimate(word, goal string) int
+}
+
+// HeuristicFunc adapts a function to the Heuristic interface
+type HeuristicFunc func(word, goal string) int
+
+// Estimate implements Heuristic interface
+func (heuristic HeuristicFunc) Estimate(word, goal string) int {
+	return heuristic(word, goal)
+}
+
+// InformedSolver is implemented by solvers guided by a Heuristic. A nil
+// Heuristic restores the lower bound of the solver MoveGenerator
+type InformedSolver interface {
+	SetHeuristic(heuristic Heuristic)
+	Heuristic() Heuristic
+}
+
+// checkHeuristicMoves return an error if heuristic only bounds one letter
+// substitutions while moves are other move rules
+func checkHeuristicMoves(heuristic Heuristic, moves MoveGenerator) error {
+	if _, ok := moves.(SubstitutionMoves); moves == nil || ok {
+		return nil
+	}
+	switch heuristic.(type) {
+	case HammingHeuristic:
+		return ErrorHeuristicWithMoves
+	case *LandmarkHeuristic:
+		return ErrorLandmarksWithMoves
+	}
+	return nil
+}
+
+// ParseHeuristic return the heuristic named hamming or alphabet. Landmark
+// heuristics need a graph, see NewLandmarkHeuristic
+func ParseHeuristic(name string) (Heuristic, error) {
+	switch strings.ToLower(name) {
+	case "hamming":
+		return HammingHeuristic{}, nil
+	case "alphabet":
+		return NewAlphabetHeuristic(), nil
+	}
+	return nil, ErrorUnknownHeuristic
+}
+
+// HammingHeuristic counts the letters of word which differ from goal, using the
+// scoring function shared by solvers. It never overestimates the number of one
+// letter substitutions, so A* guided by it stays optimal. Words of different
+// lengths are estimated by their length difference, as a move inserting or
+// deleting letters changes the length by one letter
+type HammingHeuristic struct{}
+
+// Estimate implements Heuristic interface
+func (HammingHeuristic) Estimate(word, goal string) int {
+	wordLength := len([]rune(word))
+	goalLength := len([]rune(goal))
+	if wordLength != goalLength {
+		if wordLength > goalLength {
+			return wordLength - goalLength
+		}
+		return goalLength - wordLength
+	}
+	return goalLength - getScoreBetweenTwoWord(word, goal)
+}
+
+// AlphabetHeuristic is a Hamming distance where replacing a vowel by a consonant,
+// or a consonant by a vowel, counts twice as such moves are rare in dictionaries.
+// It may overestimate : A* guided by it expands less words but may return
+// longer word chains
+type AlphabetHeuristic struct {
+	Vowels string
+}
+
+// NewAlphabetHeuristic is an AlphabetHeuristic constructor with english vowels
+func NewAlphabetHeuristic() AlphabetHeuristic {
+	return AlphabetHeuristic{Vowels: "aeiouy"}
+}
+
+// Estimate implements Heuristic interface
+func (heuristic AlphabetHeuristic) Estimate(word, goal string) int {
+	wordChars := []rune(word)
+	goalChars := []rune(goal)
+	if len(wordChars) != len(goalChars) {
+		return HammingHeuristic{}.Estimate(word, goal)
+	}
+	estimate := 0
+	for index, char := range wordChars {
+		if char == goalChars[index] {
+			continue
+		}
+		estimate++
+		if strings.ContainsRune(heuristic.Vowels, char) != strings.ContainsRune(heuristic.Vowels, goalChars[index]) {
+			estimate++
+		}
+	}
+	return estimate
+}
+
+// LandmarkHeuristic bounds the distance between two words with the triangle
+// inequality, from the distances to a few landmark words computed once (ALT).
+// The bound is never below the Hamming distance and never overestimates the
+// number of one letter substitutions, so A* guided by it stays optimal.
+// Landmarks only bound distances inside their own component
+type LandmarkHeuristic struct {
+	Checksum    string              `json:"checksum"`
+	Components  []LandmarkComponent `json:"components"`
+	componentOf map[string]int
+}
+
+// LandmarkComponent holds the landmarks of a graph component and, for every
+// word of the component, its distance to each landmark
+type LandmarkComponent struct {
+	Landmarks []string         `json:"landmarks"`
+	Distances map[string][]int `json:"distances"`
+}
+
+// NewLandmarkHeuristic walks the graph from every landmark word. Landmarks are
+// best spread far from each other, at the edges of their component, see
+// SelectLandmarks
+func NewLandmarkHeuristic(graph *WordGraph, landmarks ...string) (*LandmarkHeuristic, error) {
+	heuristic := &LandmarkHeuristic{}
+	componentPositions := make(map[int]int)
+	walker := newGraphWalker(graph)
+	for _, landmark := range landmarks {
+		index, ok := graph.indexes[landmark]
+		if !ok {
+			return nil, ErrorWordNotFoundInDB
+		}
+		position, ok := componentPositions[graph.components[index]]
+		if !ok {
+			position = len(heuristic.Components)
+			componentPositions[graph.components[index]] = position
+			heuristic.Components = append(heuristic.Components, LandmarkComponent{Distances: make(map[string][]int)})
+		}
+		component := &heuristic.Components[position]
+		component.Landmarks = append(component.Landmarks, landmark)
+		walker.walk(index)
+		for _, reached := range walker.order {
+			word := graph.words[reached]
+			component.Distances[word] = append(component.Distances[word], walker.distances[reached])
+		}
+	}
+	heuristic.indexWords()
+	return heuristic, nil
+}
+
+// LandmarkHeuristic return a LandmarkHeuristic on the loaded database graph
+func (wcr *WordChainsResolver) LandmarkHeuristic(landmarks ...string) (*LandmarkHeuristic, error) {
+	normalizedLandmarks := make([]string, len(landmarks))
+	for index, landmark := range landmarks {
+		normalizedLandmarks[index] = wcr.Normalize(landmark)
+	}
+	heuristic, err := NewLandmarkHeuristic(wcr.Graph(), normalizedLandmarks...)
+	if err != nil {
+		return nil, err
+	}
+	heuristic.Checksum = wcr.DictionaryChecksum()
+	return heuristic, nil
+}
+
+func (heuristic *LandmarkHeuristic) indexWords() {
+	heuristic.componentOf = make(map[string]int)
+	for position, component := range heuristic.Components {
+		for word := range component.Distances {
+			heuristic.componentOf[word] = position
+		}
+	}
+}
+
+// Landmarks return the landmark words of every component
+func (heuristic *LandmarkHeuristic) Landmarks() []string {
+	var landmarks []string
+	for _, component := range heuristic.Components {
+		landmarks = append(landmarks, component.Landmarks...)
+	}
+	return landmarks
+}
+
+// Estimate implements Heuristic interface. Words outside the components of
+// the landmarks are estimated with the Hamming distance
+func (heuristic *LandmarkHeuristic) Estimate(word, goal string) int {
+	estimate := HammingHeuristic{}.Estimate(word, goal)
+	wordComponent, wordOk := heuristic.componentOf[word]
+	goalComponent, goalOk := heuristic.componentOf[goal]
+	if !wordOk || !goalOk {
+		return estimate
+	}
+	if wordComponent != goalComponent {
+		return unreachableEstimate
+	}
+	distances := heuristic.Components[wordComponent].Distances
+	goalDistances := distances[goal]
+	for landmark, wordDistance := range distances[word] {
+		bound := goalDistances[landmark] - wordDistance
+		if bound < 0 {
+			bound = -bound
+		}
+		if bound > estimate {
+			estimate = bound
+		}
+	}
+	return estimate
+}
@@ -0,0 +1,173 @@
+package wordchainsresolver
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeuristicFunc_Estimate(t *testing.T) {
+	heuristic := HeuristicFunc(func(word, goal string) int {
+		return len(word) + len(goal)
+	})
+	assert.Equal(t, 7, heuristic.Estimate("cat", "code"))
+}
+
+func TestHammingHeuristic(t *testing.T) {
+	assert.Equal(t, 0, HammingHeuristic{}.Estimate("dog", "dog"))
+	assert.Equal(t, 2, HammingHeuristic{}.Estimate("cat", "cog"))
+	assert.Equal(t, 3, HammingHeuristic{}.Estimate("cat", "dog"))
+	assert.Equal(t, 1, HammingHeuristic{}.Estimate("cat", "cane"))
+	assert.Equal(t, 2, HammingHeuristic{}.Estimate("canes", "cat"))
+}
+
+func TestParseHeuristic(t *testing.T) {
+	heuristic, err := ParseHeuristic("Hamming")
+	assert.Nil(t, err)
+	assert.Equal(t, HammingHeuristic{}, heuristic)
+	heuristic, err = ParseHeuristic("alphabet")
+	assert.Nil(t, err)
+	assert.Equal(t, NewAlphabetHeuristic(), heuristic)
+	_, err = ParseHeuristic("euclid")
+	assert.Equal(t, ErrorUnknownHeuristic, err)
+}
+
+func TestAlphabetHeuristic(t *testing.T) {
+	heuristic := NewAlphabetHeuristic()
+	assert.Equal(t, 0, heuristic.Estimate("dog", "dog"))
+	assert.Equal(t, 1, heuristic.Estimate("cat", "cot"))
+	assert.Equal(t, 3, heuristic.Estimate("cat", "dog"))
+	// ape and apt differ by one letter, the estimate is not admissible
+	assert.Equal(t, 2, heuristic.Estimate("ape", "apt"))
+	// words of different lengths are estimated like hamming does
+	assert.Equal(t, 1, heuristic.Estimate("ape", "cart"))
+}
+
+func TestLandmarkHeuristic(t *testing.T) {
+	graph := NewWordGraph([]string{"cat", "cot", "cog", "dog", "dot", "hog", "bird", "bard"})
+	heuristic, err := NewLandmarkHeuristic(graph, "cat", "bird")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cat", "bird"}, heuristic.Landmarks())
+	assert.Equal(t, 3, heuristic.Estimate("cat", "dog"))
+	assert.Equal(t, 3, heuristic.Estimate("dog", "cat"))
+	// hog and dog differ by one letter but are both 3 steps away from cat
+	assert.Equal(t, 1, heuristic.Estimate("hog", "dog"))
+	assert.Equal(t, 1, heuristic.Estimate("bard", "bird"))
+	assert.Equal(t, unreachableEstimate, heuristic.Estimate("cat", "bird"))
+	assert.Equal(t, 2, heuristic.Estimate("cat", "zzt"))
+
+	_, err = NewLandmarkHeuristic(graph, "www")
+	assert.Equal(t, ErrorWordNotFoundInDB, err)
+}
+
+// loadThreeLetterWords return the three letter words of small_en.txt
+func loadThreeLetterWords(t *testing.T) []string {
+	factory := NewFileLoaderFactory(os.Getenv("GOPATH") + "/src/github.com/clnbs/wordChains/assets/app/small_en.txt")
+	wordList, err := factory.LoadDB()
+	assert.Nil(t, err)
+	var words []string
+	for _, word := range wordList {
+		if len([]rune(word)) == 3 {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func TestHeuristics_Admissible(t *testing.T) {
+	words := loadThreeLetterWords(t)
+	graph := NewWordGraph(words)
+	landmarkHeuristic, err := NewLandmarkHeuristic(graph, "cat", "zoo", "ebb")
+	assert.Nil(t, err)
+	heuristics := map[string]Heuristic{"hamming": HammingHeuristic{}, "landmark": landmarkHeuristic}
+
+	walker := newGraphWalker(graph)
+	overestimates := 0
+	for goalIndex, goal := range graph.words {
+		walker.walk(goalIndex)
+		for _, wordIndex := range walker.order {
+			word := graph.words[wordIndex]
+			for name, heuristic := range heuristics {
+				if heuristic.Estimate(word, goal) > walker.distances[wordIndex] {
+					t.Fatal(name, "overestimates the distance from", word, "to", goal)
+				}
+			}
+			if NewAlphabetHeuristic().Estimate(word, goal) > walker.distances[wordIndex] {
+				overestimates++
+			}
+		}
+	}
+	assert.NotEqual(t, 0, overestimates)
+}
+
+func TestAStarSolver_AdmissibleHeuristicsStayOptimal(t *testing.T) {
+	words := loadThreeLetterWords(t)
+	graph := NewWordGraph(words)
+	landmarkHeuristic, err := NewLandmarkHeuristic(graph, "cat", "zoo", "ebb")
+	assert.Nil(t, err)
+	walker := newGraphWalker(graph)
+	pairs := [][2]string{{"cat", "dog"}, {"ape", "zoo"}, {"ink", "owl"}, {"ebb", "tin"}, {"fly", "car"}, {"sky", "ban"}}
+	for _, pair := range pairs {
+		walker.walk(graph.indexes[pair[0]])
+		distance := walker.distances[graph.indexes[pair[1]]]
+		if distance == -1 {
+			continue
+		}
+		for _, heuristic := range []Heuristic{HammingHeuristic{}, landmarkHeuristic} {
+			result, err := NewAStarSolverWithHeuristic(heuristic).FindWordChains(pair[0], pair[1], words)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(result))
+			assert.Equal(t, distance+1, len(result[0]), "%v %T", result[0], heuristic)
+		}
+	}
+}
+
+func TestInformedSolvers_SetHeuristic(t *testing.T) {
+	estimates := 0
+	countingHeuristic := HeuristicFunc(func(word, goal string) int {
+		estimates++
+		return HammingHeuristic{}.Estimate(word, goal)
+	})
+	wordList := []string{"cat", "cot", "cog", "dog", "dot", "hat", "hot", "hog"}
+	for _, solver := range []Solver{NewAStarSolver(), NewGreedySolver(), NewBeamSolver(1)} {
+		informedSolver := solver.(InformedSolver)
+		informedSolver.SetHeuristic(countingHeuristic)
+		estimates = 0
+		result, err := solver.FindWordChains("cat", "dog", wordList)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, result)
+		for _, chain := range result {
+			assert.Equal(t, 4, len(chain), chain)
+		}
+		assert.NotEqual(t, 0, estimates)
+
+		informedSolver.SetHeuristic(nil)
+		estimates = 0
+		_, err = solver.FindWordChains("cat", "dog", wordList)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, estimates)
+	}
+}
+
+func TestWordChainsResolver_SolveWithHeuristicMoves(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockListFactory{words: []string{"cat", "cot", "cog", "dog", "cart", "care"}})
+	assert.Nil(t, wcr.LoadDB())
+	for _, solver := range []Solver{NewAStarSolver(), NewGreedySolver(), NewBeamSolver(1)} {
+		solver.(InformedSolver).SetHeuristic(HammingHeuristic{})
+		result, err := wcr.SolveWith(solver, "cat", "dog", WithMoveGenerator(SubstitutionMoves{}))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+
+		// hamming overestimates insertions and deletions
+		_, err = wcr.SolveWith(solver, "cat", "care", WithMoveGenerator(InsertDeleteMoves{}))
+		assert.Equal(t, ErrorHeuristicWithMoves, err)
+		solver.(MoveGeneratingSolver).SetMoveGenerator(CombineMoves(SubstitutionMoves{}, AnagramMoves{}))
+		_, err = wcr.SolveWith(solver, "cat", "dog")
+		assert.Equal(t, ErrorHeuristicWithMoves, err)
+
+		solver.(InformedSolver).SetHeuristic(NewAlphabetHeuristic())
+		_, err = wcr.SolveWith(solver, "cat", "dog")
+		assert.Nil(t, err)
+	}
+}
@@ -0,0 +1,93 @@
+package wordchainsresolver
+
+import "sort"
+
+// maxCachedTargets is the number of distance to target maps kept by a resolver
+const maxCachedTargets = 64
+
+// Hint return the words following current on a shortest word chain to target,
+// the most common words first when a frequency list is used. Words too rare
+// to be used by Solve are never hinted. The distances to a target are computed
+// once and reused by the next hints to this target
+func (wcr *WordChainsResolver) Hint(current, target string) ([]string, error) {
+	current = wcr.Normalize(current)
+	target = wcr.Normalize(target)
+	graph := wcr.Graph()
+	currentIndex, currentFound := graph.indexes[current]
+	targetIndex, targetFound := graph.indexes[target]
+	if !currentFound || !targetFound {
+		return nil, ErrorWordNotFoundInDB
+	}
+	if current == target {
+		return nil, nil
+	}
+	distances := wcr.distancesTo(graph, targetIndex)
+	// current may be too rare to be reached, its distance is told by its neighbors
+	nextDistance := -1
+	for _, neighbor := range graph.neighbors[currentIndex] {
+		if distance, ok := distances[neighbor]; ok && (nextDistance == -1 || distance < nextDistance) {
+			nextDistance = distance
+		}
+	}
+	if nextDistance == -1 {
+		return nil, ErrorNoWordChain
+	}
+	var hints []string
+	for _, neighbor := range graph.neighbors[currentIndex] {
+		if distance, ok := distances[neighbor]; ok && distance == nextDistance {
+			hints = append(hints, graph.words[neighbor])
+		}
+	}
+	sort.SliceStable(hints, func(i, j int) bool {
+		return wcr.frequencies[hints[i]] > wcr.frequencies[hints[j]]
+	})
+	return hints, nil
+}
+
+// distancesTo return the distance of every word to a target, the least
+// recently used target being evicted when too many targets are cached
+func (wcr *WordChainsResolver) distancesTo(graph *WordGraph, targetIndex int) map[int]int {
+	wcr.hintMutex.Lock()
+	defer wcr.hintMutex.Unlock()
+	target := graph.words[targetIndex]
+	if distances, ok := wcr.targetDistances[target]; ok {
+		wcr.touchTarget(target)
+		return distances
+	}
+	walker := newGraphWalker(graph)
+	walker.excluded = wcr.rareWords(graph, target)
+	walker.walk(targetIndex)
+	distances := make(map[int]int, len(walker.order))
+	for _, index := range walker.order {
+		distances[index] = walker.distances[index]
+	}
+	if wcr.targetDistances == nil {
+		wcr.targetDistances = make(map[string]map[int]int)
+	}
+	if len(wcr.targetDistances) >= maxCachedTargets {
+		delete(wcr.targetDistances, wcr.targetOrder[0])
+		wcr.targetOrder = wcr.targetOrder[1:]
+	}
+	wcr.targetDistances[target] = distances
+	wcr.targetOrder = append(wcr.targetOrder, target)
+	return distances
+}
+
+// touchTarget moves target at the end of the eviction order
+func (wcr *WordChainsResolver) touchTarget(target string) {
+	for index, cachedTarget := range wcr.targetOrder {
+		if cachedTarget == target {
+			copy(wcr.targetOrder[index:], wcr.targetOrder[index+1:])
+			wcr.targetOrder[len(wcr.targetOrder)-1] = target
+			return
+		}
+	}
+}
+
+// forgetTargets drops every cached distance to target map
+func (wcr *WordChainsResolver) forgetTargets() {
+	wcr.hintMutex.Lock()
+	defer wcr.hintMutex.Unlock()
+	wcr.targetDistances = nil
+	wcr.targetOrder = nil
+}
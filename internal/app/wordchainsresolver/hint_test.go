@@ -0,0 +1,95 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordChainsResolver_Hint(t *testing.T) {
+	wcr := NewWordChainsResolver(NewBFSSolver(), &MockListFactory{words: []string{"cat", "cot", "cog", "dog", "dot", "cut", "ebb"}})
+	assert.Nil(t, wcr.LoadDB())
+
+	hints, err := wcr.Hint("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cot"}, hints)
+	hints, err = wcr.Hint("cot", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cog", "dot"}, hints)
+	assert.Equal(t, 1, len(wcr.targetDistances))
+
+	wcr.UseFrequencies(FrequencyList{"dot": 10, "cog": 1}, 0)
+	hints, err = wcr.Hint("cot", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"dot", "cog"}, hints)
+
+	hints, err = wcr.Hint("dog", "dog")
+	assert.Nil(t, err)
+	assert.Nil(t, hints)
+	_, err = wcr.Hint("cat", "ebb")
+	assert.Equal(t, ErrorNoWordChain, err)
+	_, err = wcr.Hint("cat", "www")
+	assert.Equal(t, ErrorWordNotFoundInDB, err)
+
+	assert.Nil(t, wcr.LoadDB())
+	assert.Nil(t, wcr.targetDistances)
+}
+
+func TestWordChainsResolver_HintMinFrequency(t *testing.T) {
+	wcr := NewWordChainsResolver(NewBFSSolver(), &MockListFactory{words: []string{"cat", "cot", "cog", "dog", "dot", "cut", "hut", "hot", "hog"}})
+	assert.Nil(t, wcr.LoadDB())
+	hints, err := wcr.Hint("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cot"}, hints)
+
+	wcr.UseFrequencies(FrequencyList{"cut": 5, "hut": 5, "hot": 5, "hog": 5, "cog": 5}, 2)
+	assert.Nil(t, wcr.targetDistances)
+	hints, err = wcr.Hint("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cut"}, hints)
+	result, err := wcr.Solve("cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cut", "hut", "hot", "hog", "dog"}}, result)
+	// a rare current word is still given the hints around it
+	hints, err = wcr.Hint("cot", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cog"}, hints)
+}
+
+func TestWordChainsResolver_HintEviction(t *testing.T) {
+	var words []string
+	for _, first := range "abcdefghijklmnopqrstuvwxyz" {
+		for _, last := range "abc" {
+			words = append(words, string(first)+string(last))
+		}
+	}
+	wcr := NewWordChainsResolver(nil, &MockListFactory{words: words})
+	assert.Nil(t, wcr.LoadDB())
+	for index := 0; index < maxCachedTargets; index++ {
+		_, err := wcr.Hint("aa", words[index+1])
+		assert.Nil(t, err)
+	}
+	_, err := wcr.Hint("aa", words[1])
+	assert.Nil(t, err)
+	_, err = wcr.Hint("aa", "zc")
+	assert.Nil(t, err)
+	assert.Equal(t, maxCachedTargets, len(wcr.targetDistances))
+	// the least recently used target is evicted, the others are kept
+	for target, cached := range map[string]bool{words[1]: true, words[2]: false, words[3]: true, "zc": true} {
+		_, ok := wcr.targetDistances[target]
+		assert.Equal(t, cached, ok, target)
+	}
+}
+
+func TestGame_Hint(t *testing.T) {
+	game, err := newMockGameResolver(t).NewGame("cat", "dog")
+	assert.Nil(t, err)
+	hints, err := game.Hint()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cot"}, hints)
+	assert.Nil(t, game.Play("cot"))
+	assert.Nil(t, game.Play("cut"))
+	hints, err = game.Hint()
+	assert.Nil(t, err)
+	assert.Nil(t, hints)
+}
@@ -0,0 +1,89 @@
+package wordchainsresolver
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// ErrorLandmarksOutdated is trigger when landmarks were computed on another
+// dictionary than the loaded one
+var ErrorLandmarksOutdated = errors.New("landmarks : computed on another dictionary")
+
+// ErrorLandmarksWithMoves is trigger when a solver guided by landmarks searches
+// with move rules other than one letter substitutions, landmark distances
+// being measured with substitutions only
+var ErrorLandmarksWithMoves = errors.New("landmarks : only guide one letter substitutions")
+
+// SelectLandmarks picks up to count landmark words in every component of at
+// least minComponentSize words. The first landmark of a component is the word
+// farthest from one of its words, each next one is the word farthest from the
+// landmarks already picked
+func SelectLandmarks(graph *WordGraph, count, minComponentSize int) []string {
+	if minComponentSize < 2 {
+		minComponentSize = 2
+	}
+	var landmarks []string
+	walker := newGraphWalker(graph)
+	selectedComponents := make(map[int]interface{})
+	for index := range graph.words {
+		componentID := graph.components[index]
+		if _, ok := selectedComponents[componentID]; ok {
+			continue
+		}
+		selectedComponents[componentID] = nil
+		if graph.componentSizes[componentID] < minComponentSize {
+			continue
+		}
+		walker.walk(index)
+		componentLandmarks := []int{walker.farthest()[0]}
+		for len(componentLandmarks) < count {
+			walker.walk(componentLandmarks...)
+			farthest := walker.farthest()[0]
+			if walker.distances[farthest] == 0 {
+				break
+			}
+			componentLandmarks = append(componentLandmarks, farthest)
+		}
+		for _, landmark := range componentLandmarks {
+			landmarks = append(landmarks, graph.words[landmark])
+		}
+	}
+	return landmarks
+}
+
+// PrecomputeLandmarks selects count landmarks in every component of at least
+// minComponentSize words of the loaded database and walks the graph from them
+func (wcr *WordChainsResolver) PrecomputeLandmarks(count, minComponentSize int) *LandmarkHeuristic {
+	graph := wcr.Graph()
+	// selected landmarks are in the graph, no error can happen
+	heuristic, _ := NewLandmarkHeuristic(graph, SelectLandmarks(graph, count, minComponentSize)...)
+	heuristic.Checksum = wcr.DictionaryChecksum()
+	return heuristic
+}
+
+// CheckLandmarkHeuristic return ErrorLandmarksOutdated if landmarks were not
+// computed on the loaded database. Solve return ErrorLandmarksWithMoves if they
+// guide a search with other moves than one letter substitutions
+func (wcr *WordChainsResolver) CheckLandmarkHeuristic(heuristic *LandmarkHeuristic) error {
+	if heuristic.Checksum != wcr.DictionaryChecksum() {
+		return ErrorLandmarksOutdated
+	}
+	return nil
+}
+
+// Save writes the landmarks and their distances as JSON
+func (heuristic *LandmarkHeuristic) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(heuristic)
+}
+
+// LoadLandmarkHeuristic reads landmarks written by Save
+func LoadLandmarkHeuristic(r io.Reader) (*LandmarkHeuristic, error) {
+	heuristic := &LandmarkHeuristic{}
+	err := json.NewDecoder(r).Decode(heuristic)
+	if err != nil {
+		return nil, err
+	}
+	heuristic.indexWords()
+	return heuristic, nil
+}
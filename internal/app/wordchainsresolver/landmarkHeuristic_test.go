@@ -0,0 +1,82 @@
+package wordchainsresolver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockWordsList_Landmarks = []string{"cat", "cot", "cog", "dog", "dot", "hog", "bird", "bard"}
+
+func TestSelectLandmarks(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_Landmarks)
+	assert.Equal(t, []string{"hog", "cat", "bard", "bird"}, SelectLandmarks(graph, 2, 0))
+	assert.Equal(t, []string{"hog", "bard"}, SelectLandmarks(graph, 1, 2))
+	assert.Equal(t, []string{"hog", "cat"}, SelectLandmarks(graph, 2, 3))
+	assert.Empty(t, SelectLandmarks(graph, 2, 10))
+}
+
+func TestWordChainsResolver_PrecomputeLandmarks(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockListFactory{words: mockWordsList_Landmarks})
+	assert.Nil(t, wcr.LoadDB())
+	heuristic := wcr.PrecomputeLandmarks(2, 3)
+	assert.Equal(t, []string{"hog", "cat"}, heuristic.Landmarks())
+	assert.Nil(t, wcr.CheckLandmarkHeuristic(heuristic))
+	assert.Equal(t, 3, heuristic.Estimate("cat", "dog"))
+	// bird and bard have no landmark
+	assert.Equal(t, 1, heuristic.Estimate("bard", "bird"))
+
+	buffer := &bytes.Buffer{}
+	assert.Nil(t, heuristic.Save(buffer))
+	loaded, err := LoadLandmarkHeuristic(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, heuristic, loaded)
+	assert.Equal(t, 3, loaded.Estimate("cat", "dog"))
+	_, err = LoadLandmarkHeuristic(bytes.NewBufferString("{"))
+	assert.NotNil(t, err)
+
+	heuristic, err = wcr.LandmarkHeuristic("cat")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cat"}, heuristic.Landmarks())
+	assert.Nil(t, wcr.CheckLandmarkHeuristic(heuristic))
+
+	other := NewWordChainsResolver(nil, &MockListFactory{words: []string{"cat", "cot"}})
+	assert.Nil(t, other.LoadDB())
+	assert.Equal(t, ErrorLandmarksOutdated, other.CheckLandmarkHeuristic(heuristic))
+
+	// landmark distances count one letter substitutions only
+	solver := NewAStarSolverWithHeuristic(heuristic)
+	result, err := wcr.SolveWith(solver, "cat", "dog", WithMoveGenerator(SubstitutionMoves{}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 4, len(result[0]))
+	_, err = wcr.SolveWith(solver, "cat", "dog", WithMoveGenerator(AnagramMoves{}))
+	assert.Equal(t, ErrorLandmarksWithMoves, err)
+	assert.Nil(t, solver.MoveGenerator())
+}
+
+func TestAStarSolver_PrecomputedLandmarks(t *testing.T) {
+	words := loadThreeLetterWords(t)
+	wcr := NewWordChainsResolver(nil, &MockListFactory{words: words})
+	assert.Nil(t, wcr.LoadDB())
+	landmarkHeuristic := wcr.PrecomputeLandmarks(4, 2)
+
+	counter := NewCounterObserver()
+	expanded := make(map[string]int)
+	for name, heuristic := range map[string]Heuristic{"hamming": HammingHeuristic{}, "landmark": landmarkHeuristic} {
+		solver := NewAStarSolverWithHeuristic(heuristic)
+		solver.SetObserver(counter)
+		for _, pair := range [][2]string{{"cat", "dog"}, {"ape", "zoo"}, {"ink", "owl"}, {"fly", "car"}} {
+			result, err := wcr.SolveWith(solver, pair[0], pair[1])
+			assert.Nil(t, err)
+			distanceMap, err := wcr.DistanceMapFrom(pair[0])
+			assert.Nil(t, err)
+			distance, _ := distanceMap.Distance(pair[1])
+			assert.Equal(t, distance+1, len(result[0]), "%s %v", name, result[0])
+		}
+		expanded[name] = counter.Count(NodeExpanded)
+		counter.Reset()
+	}
+	assert.True(t, expanded["landmark"] < expanded["hamming"], expanded)
+}
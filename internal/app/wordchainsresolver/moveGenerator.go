@@ -0,0 +1,289 @@
+package wordchainsresolver
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrorUnknownMoveGenerator is trigger when a move generator name is unknown
+	ErrorUnknownMoveGenerator = errors.New("moves : unknown move generator")
+
+	// ErrorMoveGeneratorNotSupported is trigger when a move generator is given
+	// to a solver which does not implement the MoveGeneratingSolver interface
+	ErrorMoveGeneratorNotSupported = errors.New("query : the solver does not support move generators")
+)
+
+// MoveGenerator tells which words may follow each other in a word chain
+type MoveGenerator interface {
+	// IsMove tells if nextWord may follow word
+	IsMove(word, nextWord string) bool
+	// PreservesLength tells if every move keeps the word length, solvers
+	// then only consider the words as long as the first word
+	PreservesLength() bool
+	// MinMoves return a lower bound of the number of moves from word to goal,
+	// A* stays optimal as long as it never overestimates
+	MinMoves(word, goal string) int
+}
+
+// MoveGeneratingSolver is implemented by solvers generating their moves with
+// a MoveGenerator. A nil MoveGenerator restores one letter substitutions
+type MoveGeneratingSolver interface {
+	SetMoveGenerator(moves MoveGenerator)
+	MoveGenerator() MoveGenerator
+}
+
+// SubstitutionMoves is the classic rule : a move changes exactly one letter
+type SubstitutionMoves struct{}
+
+// IsMove implements MoveGenerator interface
+func (SubstitutionMoves) IsMove(word, nextWord string) bool {
+	return isPossibleNextWord(nextWord, word)
+}
+
+// PreservesLength implements MoveGenerator interface
+func (SubstitutionMoves) PreservesLength() bool {
+	return true
+}
+
+// MinMoves implements MoveGenerator interface, it counts the letters which differ
+func (SubstitutionMoves) MinMoves(word, goal string) int {
+	return HammingHeuristic{}.Estimate(word, goal)
+}
+
+// InsertDeleteMoves is a rule where a move inserts or deletes exactly one letter
+type InsertDeleteMoves struct{}
+
+// IsMove implements MoveGenerator interface
+func (InsertDeleteMoves) IsMove(word, nextWord string) bool {
+	wordChars := []rune(word)
+	nextWordChars := []rune(nextWord)
+	if len(wordChars) == len(nextWordChars)+1 {
+		wordChars, nextWordChars = nextWordChars, wordChars
+	}
+	if len(wordChars)+1 != len(nextWordChars) {
+		return false
+	}
+	// nextWordChars is the longest word, it must be wordChars with one more letter
+	index := 0
+	for index < len(wordChars) && wordChars[index] == nextWordChars[index] {
+		index++
+	}
+	return string(wordChars[index:]) == string(nextWordChars[index+1:])
+}
+
+// PreservesLength implements MoveGenerator interface
+func (InsertDeleteMoves) PreservesLength() bool {
+	return false
+}
+
+// MinMoves implements MoveGenerator interface, it counts the letters to delete
+// and insert around the longest common subsequence of word and goal
+func (InsertDeleteMoves) MinMoves(word, goal string) int {
+	wordChars := []rune(word)
+	goalChars := []rune(goal)
+	return len(wordChars) + len(goalChars) - 2*longestCommonSubsequence(wordChars, goalChars)
+}
+
+// AnagramMoves is a rule where a move reorders the letters of a word
+type AnagramMoves struct{}
+
+// IsMove implements MoveGenerator interface
+func (AnagramMoves) IsMove(word, nextWord string) bool {
+	return word != nextWord && sortedLetters(word) == sortedLetters(nextWord)
+}
+
+// PreservesLength implements MoveGenerator interface
+func (AnagramMoves) PreservesLength() bool {
+	return true
+}
+
+// MinMoves implements MoveGenerator interface
+func (AnagramMoves) MinMoves(word, goal string) int {
+	return atLeastOneMove(word, goal)
+}
+
+// MoveFunc adapts a function to the MoveGenerator interface, for custom rules.
+// Words of any length are considered and A* is not guided
+type MoveFunc func(word, nextWord string) bool
+
+// IsMove implements MoveGenerator interface
+func (moves MoveFunc) IsMove(word, nextWord string) bool {
+	return moves(word, nextWord)
+}
+
+// PreservesLength implements MoveGenerator interface
+func (moves MoveFunc) PreservesLength() bool {
+	return false
+}
+
+// MinMoves implements MoveGenerator interface
+func (moves MoveFunc) MinMoves(word, goal string) int {
+	return atLeastOneMove(word, goal)
+}
+
+// CombinedMoves allows the moves of every MoveGenerator it holds
+type CombinedMoves []MoveGenerator
+
+// CombineMoves return a MoveGenerator allowing the moves of every generator
+func CombineMoves(generators ...MoveGenerator) CombinedMoves {
+	return CombinedMoves(generators)
+}
+
+// IsMove implements MoveGenerator interface
+func (moves CombinedMoves) IsMove(word, nextWord string) bool {
+	for _, generator := range moves {
+		if generator.IsMove(word, nextWord) {
+			return true
+		}
+	}
+	return false
+}
+
+// PreservesLength implements MoveGenerator interface
+func (moves CombinedMoves) PreservesLength() bool {
+	for _, generator := range moves {
+		if !generator.PreservesLength() {
+			return false
+		}
+	}
+	return true
+}
+
+// MinMoves implements MoveGenerator interface. Chains mixing moves can be
+// shorter than every generator bound, so only one move is guaranteed
+func (moves CombinedMoves) MinMoves(word, goal string) int {
+	return atLeastOneMove(word, goal)
+}
+
+// ParseMoveGenerator return the MoveGenerator named substitution, insert-delete
+// or anagram. A comma separated list of names combines their moves
+func ParseMoveGenerator(names string) (MoveGenerator, error) {
+	var generators CombinedMoves
+	for _, name := range strings.Split(names, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "substitution":
+			generators = append(generators, SubstitutionMoves{})
+		case "insert-delete":
+			generators = append(generators, InsertDeleteMoves{})
+		case "anagram":
+			generators = append(generators, AnagramMoves{})
+		default:
+			return nil, ErrorUnknownMoveGenerator
+		}
+	}
+	if len(generators) == 1 {
+		return generators[0], nil
+	}
+	return generators, nil
+}
+
+// moveGeneratorName return the names moves are parsed from by ParseMoveGenerator,
+// false if moves are a custom rule. A nil MoveGenerator has no name
+func moveGeneratorName(moves MoveGenerator) (string, bool) {
+	switch moves := moves.(type) {
+	case nil:
+		return "", true
+	case SubstitutionMoves:
+		return "substitution", true
+	case InsertDeleteMoves:
+		return "insert-delete", true
+	case AnagramMoves:
+		return "anagram", true
+	case CombinedMoves:
+		names := make([]string, len(moves))
+		for index, generator := range moves {
+			name, ok := moveGeneratorName(generator)
+			if !ok || name == "" {
+				return "", false
+			}
+			names[index] = name
+		}
+		return strings.Join(names, ","), true
+	}
+	return "", false
+}
+
+// allowsMove tells if a solver using moves and constraint may go from word to
+// nextWord, looking for goal. A nil MoveGenerator makes one letter substitutions
+func allowsMove(moves MoveGenerator, constraint *ChainConstraint, word, nextWord, goal string) bool {
+	if moves == nil {
+		moves = SubstitutionMoves{}
+	}
+	return moves.IsMove(word, nextWord) && constraint.AllowsMove(word, nextWord, goal)
+}
+
+// candidateWords return the words of wordList a solver starting from from may
+// use, from excepted
+func candidateWords(moves MoveGenerator, from string, wordList []string) []string {
+	sameLength := preservesLength(moves)
+	fromLength := len([]rune(from))
+	var candidates []string
+	for _, word := range wordList {
+		if word != from && (!sameLength || len([]rune(word)) == fromLength) {
+			candidates = append(candidates, word)
+		}
+	}
+	return candidates
+}
+
+// symmetricMoves tells if nextWord is a move from word whenever word is a
+// move from nextWord. Custom MoveFunc rules may not be
+func symmetricMoves(moves MoveGenerator) bool {
+	switch moves := moves.(type) {
+	case nil, SubstitutionMoves, InsertDeleteMoves, AnagramMoves:
+		return true
+	case CombinedMoves:
+		for _, generator := range moves {
+			if !symmetricMoves(generator) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func preservesLength(moves MoveGenerator) bool {
+	return moves == nil || moves.PreservesLength()
+}
+
+func minMoves(moves MoveGenerator, word, goal string) int {
+	if moves == nil {
+		moves = SubstitutionMoves{}
+	}
+	return moves.MinMoves(word, goal)
+}
+
+func atLeastOneMove(word, goal string) int {
+	if word == goal {
+		return 0
+	}
+	return 1
+}
+
+func sortedLetters(word string) string {
+	letters := []rune(word)
+	sort.Slice(letters, func(i, j int) bool {
+		return letters[i] < letters[j]
+	})
+	return string(letters)
+}
+
+func longestCommonSubsequence(word1, word2 []rune) int {
+	lengths := make([]int, len(word2)+1)
+	for _, char1 := range word1 {
+		previousDiagonal := 0
+		for index, char2 := range word2 {
+			previous := lengths[index+1]
+			if char1 == char2 {
+				lengths[index+1] = previousDiagonal + 1
+			} else if lengths[index] > lengths[index+1] {
+				lengths[index+1] = lengths[index]
+			}
+			previousDiagonal = previous
+		}
+	}
+	return lengths[len(word2)]
+}
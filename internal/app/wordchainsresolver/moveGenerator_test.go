@@ -0,0 +1,128 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockWordsList_MoveGenerator = []string{"cat", "ca", "can", "cane", "at", "ant", "cant", "act", "ace", "cot"}
+
+func TestSubstitutionMoves(t *testing.T) {
+	moves := SubstitutionMoves{}
+	assert.True(t, moves.IsMove("cat", "cot"))
+	assert.False(t, moves.IsMove("cat", "cat"))
+	assert.False(t, moves.IsMove("cat", "cant"))
+	assert.True(t, moves.PreservesLength())
+	assert.Equal(t, 2, moves.MinMoves("cat", "cog"))
+}
+
+func TestInsertDeleteMoves(t *testing.T) {
+	moves := InsertDeleteMoves{}
+	assert.True(t, moves.IsMove("cat", "cant"))
+	assert.True(t, moves.IsMove("cant", "can"))
+	assert.True(t, moves.IsMove("cat", "scat"))
+	assert.True(t, moves.IsMove("at", "a"))
+	assert.False(t, moves.IsMove("cat", "cot"))
+	assert.False(t, moves.IsMove("cat", "cat"))
+	assert.False(t, moves.IsMove("cat", "tack"))
+	assert.False(t, moves.PreservesLength())
+	assert.Equal(t, 3, moves.MinMoves("cat", "cane"))
+	assert.Equal(t, 0, moves.MinMoves("cat", "cat"))
+	assert.Equal(t, 2, moves.MinMoves("ab", "ba"))
+}
+
+func TestAnagramMoves(t *testing.T) {
+	moves := AnagramMoves{}
+	assert.True(t, moves.IsMove("cat", "act"))
+	assert.False(t, moves.IsMove("cat", "cat"))
+	assert.False(t, moves.IsMove("cat", "cot"))
+	assert.True(t, moves.PreservesLength())
+	assert.Equal(t, 1, moves.MinMoves("cat", "ace"))
+	assert.Equal(t, 0, moves.MinMoves("cat", "cat"))
+}
+
+func TestCombineMoves(t *testing.T) {
+	moves := CombineMoves(SubstitutionMoves{}, AnagramMoves{})
+	assert.True(t, moves.IsMove("cat", "act"))
+	assert.True(t, moves.IsMove("act", "ace"))
+	assert.False(t, moves.IsMove("cat", "ace"))
+	assert.True(t, moves.PreservesLength())
+	assert.False(t, CombineMoves(SubstitutionMoves{}, InsertDeleteMoves{}).PreservesLength())
+	assert.Equal(t, 1, moves.MinMoves("cat", "ace"))
+}
+
+func TestParseMoveGenerator(t *testing.T) {
+	moves, err := ParseMoveGenerator("substitution")
+	assert.Nil(t, err)
+	assert.Equal(t, SubstitutionMoves{}, moves)
+	moves, err = ParseMoveGenerator("Insert-Delete, anagram")
+	assert.Nil(t, err)
+	assert.Equal(t, CombineMoves(InsertDeleteMoves{}, AnagramMoves{}), moves)
+	_, err = ParseMoveGenerator("swap")
+	assert.Equal(t, ErrorUnknownMoveGenerator, err)
+}
+
+func TestWordChainsResolver_SolveWithMoveGenerator(t *testing.T) {
+	lastLetterMoves := MoveFunc(func(word, nextWord string) bool {
+		return len(word) == len(nextWord) && word != nextWord && word[:len(word)-1] == nextWord[:len(nextWord)-1]
+	})
+	for _, solver := range []Solver{NewBFSSolver(), NewAStarSolver(), NewGreedySolver(), NewBeamSolver(0)} {
+		wcr := NewWordChainsResolver(solver, &MockListFactory{words: mockWordsList_MoveGenerator})
+		assert.Nil(t, wcr.LoadDB())
+
+		result, err := wcr.Solve("cat", "cane", WithMoveGenerator(InsertDeleteMoves{}))
+		assert.Nil(t, err)
+		assert.NotEmpty(t, result)
+		for _, chain := range result {
+			assert.Equal(t, 4, len(chain), chain)
+			for index := 1; index < len(chain); index++ {
+				assert.True(t, InsertDeleteMoves{}.IsMove(chain[index-1], chain[index]), chain)
+			}
+		}
+
+		result, err = wcr.Solve("cot", "ace", WithMoveGenerator(CombineMoves(SubstitutionMoves{}, AnagramMoves{})))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"cot", "cat", "act", "ace"}}, result)
+
+		result, err = wcr.Solve("can", "cat", WithMoveGenerator(lastLetterMoves))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"can", "cat"}}, result)
+
+		// moves are only used by the query they are given to
+		_, err = wcr.Solve("cat", "cane")
+		assert.Equal(t, ErrorWordLengthDoesNotMatch, err)
+		result, err = wcr.Solve("can", "cot")
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"can", "cat", "cot"}}, result)
+	}
+
+	wcr := NewWordChainsResolver(NewBFSSolver(), &MockListFactory{words: mockWordsList_MoveGenerator})
+	assert.Nil(t, wcr.LoadDB())
+	result, err := wcr.Solve("cat", "cane", WithMoveGenerator(InsertDeleteMoves{}), WithChainConstraint(ChainConstraint{BannedLetters: "n"}), Avoid("ca"))
+	assert.Nil(t, err)
+	assert.Empty(t, result)
+	result, err = wcr.Solve("cat", "cane", WithMoveGenerator(InsertDeleteMoves{}), Avoid("ca"))
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cant", "can", "cane"}}, result)
+
+	wcr = NewWordChainsResolver(NewCachingSolver(&MockCountingSolver{}, "bfs", 10), &MockListFactory{words: mockWordsList_MoveGenerator})
+	assert.Nil(t, wcr.LoadDB())
+	_, err = wcr.Solve("cat", "cane", WithMoveGenerator(InsertDeleteMoves{}))
+	assert.Equal(t, ErrorMoveGeneratorNotSupported, err)
+}
+
+func TestMoveGeneratorName(t *testing.T) {
+	for _, names := range []string{"substitution", "insert-delete", "anagram", "substitution,anagram"} {
+		moves, err := ParseMoveGenerator(names)
+		assert.Nil(t, err)
+		name, ok := moveGeneratorName(moves)
+		assert.True(t, ok)
+		assert.Equal(t, names, name)
+	}
+	name, ok := moveGeneratorName(nil)
+	assert.True(t, ok)
+	assert.Equal(t, "", name)
+	_, ok = moveGeneratorName(CombineMoves(AnagramMoves{}, MoveFunc(isPossibleNextWord)))
+	assert.False(t, ok)
+}
@@ -0,0 +1,95 @@
+package wordchainsresolver
+
+import (
+	"errors"
+	"regexp"
+)
+
+// ErrorEmptyQuery is trigger when a query has no first word or no last word
+var ErrorEmptyQuery = errors.New("query : no first word or no last word")
+
+// MultiQuery is a search from any of several first words to any of several
+// last words. Last words are the Goals and, if GoalPattern is set, every
+// word of the loaded database matching it
+type MultiQuery struct {
+	Sources     []string
+	Goals       []string
+	GoalPattern *regexp.Regexp
+	// AllGoals asks for a word chain to every last word at the optimal
+	// distance, instead of a word chain to the first last word reached
+	AllGoals bool
+}
+
+// SolveMulti return the shortest word chains from the nearest first word to
+// the last words of a query, or nil if no last word can be reached. Every
+// first word is searched at once, so the search costs a single walk ending at
+// the distance of the nearest last word. Words are normalized, and the pattern
+// is matched against normalized words. As with Solve, rare intermediate words
+// are not used and chains are ranked by commonness when frequencies are loaded
+func (wcr *WordChainsResolver) SolveMulti(query MultiQuery) ([][]string, error) {
+	graph := wcr.Graph()
+	sources, err := wcr.wordIndexes(graph, query.Sources)
+	if err != nil {
+		return nil, err
+	}
+	goals, err := wcr.wordIndexes(graph, query.Goals)
+	if err != nil {
+		return nil, err
+	}
+	isGoal := make(map[int]interface{}, len(goals))
+	for _, goal := range goals {
+		isGoal[goal] = nil
+	}
+	if query.GoalPattern != nil {
+		for index, word := range graph.words {
+			if query.GoalPattern.MatchString(word) {
+				isGoal[index] = nil
+			}
+		}
+	}
+	if len(sources) == 0 || len(isGoal) == 0 {
+		return nil, ErrorEmptyQuery
+	}
+
+	walker := newGraphWalker(graph)
+	walker.excluded = wcr.rareWords(graph)
+	if walker.excluded != nil {
+		for goal := range isGoal {
+			walker.excluded[goal] = false
+		}
+	}
+	walker.stop = func(reached int) bool {
+		_, ok := isGoal[reached]
+		return ok
+	}
+	walker.walk(sources...)
+	var chains [][]string
+	for _, reached := range walker.order {
+		if _, ok := isGoal[reached]; !ok {
+			continue
+		}
+		if len(chains) != 0 && walker.distances[reached] > len(chains[0])-1 {
+			break
+		}
+		chains = append(chains, walker.chainTo(reached))
+	}
+	if wcr.frequencies != nil && len(chains) > 1 {
+		chains = wcr.frequencies.RankChains(chains)
+	}
+	if !query.AllGoals && len(chains) > 1 {
+		chains = chains[:1]
+	}
+	return chains, nil
+}
+
+func (wcr *WordChainsResolver) wordIndexes(graph *WordGraph, words []string) ([]int, error) {
+	indexes := make([]int, 0, len(words))
+	for _, word := range words {
+		index, ok := graph.indexes[wcr.Normalize(word)]
+		if !ok {
+			return nil, ErrorWordNotFoundInDB
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
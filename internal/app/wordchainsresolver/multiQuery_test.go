@@ -0,0 +1,49 @@
+package wordchainsresolver
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordChainsResolver_SolveMulti(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockListFactory{words: mockWordsList_BeamSolver})
+	assert.Nil(t, wcr.LoadDB())
+
+	result, err := wcr.SolveMulti(MultiQuery{Sources: []string{"hit", "cat"}, Goals: []string{"dog", "dot"}})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "dot"}}, result)
+
+	result, err = wcr.SolveMulti(MultiQuery{Sources: []string{"hit", "cat"}, Goals: []string{"dog", "cog", "dot"}, AllGoals: true})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog"}, {"cat", "cot", "dot"}}, result)
+
+	result, err = wcr.SolveMulti(MultiQuery{Sources: []string{"hit"}, GoalPattern: regexp.MustCompile("^d")})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"hit", "hut", "cut", "cot", "dot"}}, result)
+
+	result, err = wcr.SolveMulti(MultiQuery{Sources: []string{"dummy"}, Goals: []string{"dog"}})
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	wcr.UseFrequencies(FrequencyList{"cog": 10, "dot": 10, "cut": 1}, 0)
+	result, err = wcr.SolveMulti(MultiQuery{Sources: []string{"cot"}, Goals: []string{"hut", "dog"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, "dog", result[0][2])
+
+	wcr.UseFrequencies(FrequencyList{"cot": 10}, 5)
+	result, err = wcr.SolveMulti(MultiQuery{Sources: []string{"cat"}, Goals: []string{"dot"}})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "dot"}}, result)
+	result, err = wcr.SolveMulti(MultiQuery{Sources: []string{"hit"}, Goals: []string{"dot"}})
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	wcr.UseFrequencies(nil, 0)
+
+	_, err = wcr.SolveMulti(MultiQuery{Sources: []string{"www"}, Goals: []string{"dog"}})
+	assert.Equal(t, ErrorWordNotFoundInDB, err)
+	_, err = wcr.SolveMulti(MultiQuery{Sources: []string{"cat"}, GoalPattern: regexp.MustCompile("^z")})
+	assert.Equal(t, ErrorEmptyQuery, err)
+}
@@ -0,0 +1,172 @@
+package wordchainsresolver
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrorUnknownNormalizationStep is trigger when a normalization pipeline
+// description contains an unknown step
+var ErrorUnknownNormalizationStep = errors.New("normalizer : unknown normalization step")
+
+// NormalizationStep transforms a word into a more canonical form
+type NormalizationStep func(string) string
+
+// Normalizer is a pipeline of NormalizationStep building the key a word
+// is searched with
+type Normalizer struct {
+	steps []NormalizationStep
+}
+
+// NewNormalizer is a Normalizer constructor, steps are applied in the given order
+func NewNormalizer(steps ...NormalizationStep) *Normalizer {
+	return &Normalizer{steps: steps}
+}
+
+// NewDefaultNormalizer is a Normalizer constructor lowering words case
+// like FileLoaderFactory does
+func NewDefaultNormalizer() *Normalizer {
+	return NewNormalizer(FoldCase(""))
+}
+
+// ParseNormalizer create a Normalizer from a comma separated list of steps.
+// Known steps are "nfc", "nfd", "accents" and "lower", the latter can be
+// followed by a locale, e.g : "nfc,accents,lower:fr"
+func ParseNormalizer(description string) (*Normalizer, error) {
+	normalizer := NewNormalizer()
+	for _, step := range strings.Split(description, ",") {
+		step = strings.TrimSpace(step)
+		stepName, locale := step, ""
+		if separatorIndex := strings.Index(step, ":"); separatorIndex != -1 {
+			stepName, locale = step[:separatorIndex], step[separatorIndex+1:]
+		}
+		switch stepName {
+		case "":
+			continue
+		case "nfc":
+			normalizer.steps = append(normalizer.steps, NFC)
+		case "nfd":
+			normalizer.steps = append(normalizer.steps, NFD)
+		case "accents":
+			normalizer.steps = append(normalizer.steps, FoldAccents)
+		case "lower":
+			normalizer.steps = append(normalizer.steps, FoldCase(locale))
+		default:
+			return nil, ErrorUnknownNormalizationStep
+		}
+	}
+	return normalizer, nil
+}
+
+// Normalize return the key of a word
+func (normalizer *Normalizer) Normalize(word string) string {
+	for _, step := range normalizer.steps {
+		word = step(word)
+	}
+	return word
+}
+
+// NFC is a NormalizationStep composing a word in Unicode canonical form
+func NFC(word string) string {
+	return norm.NFC.String(word)
+}
+
+// NFD is a NormalizationStep decomposing a word in Unicode canonical form
+func NFD(word string) string {
+	return norm.NFD.String(word)
+}
+
+// FoldAccents is a NormalizationStep removing diacritics, e.g : "é" becomes "e".
+// The result is NFC composed
+func FoldAccents(word string) string {
+	var folded []rune
+	for _, char := range norm.NFD.String(word) {
+		if unicode.Is(unicode.Mn, char) {
+			continue
+		}
+		folded = append(folded, char)
+	}
+	return norm.NFC.String(string(folded))
+}
+
+// FoldCase create a NormalizationStep lowering words case with the rules
+// of a locale, e.g : "tr" lowers "I" into "ı". An empty locale uses the
+// Unicode default rules
+func FoldCase(locale string) NormalizationStep {
+	tag := language.Und
+	if locale != "" {
+		tag = language.Make(locale)
+	}
+	return func(word string) string {
+		return cases.Lower(tag).String(word)
+	}
+}
+
+// NormalizedFactory struct implements Factory interface by normalizing the
+// words of another Factory. Words are deduplicated by key and each key
+// remembers the first spelling it was loaded with
+type NormalizedFactory struct {
+	factory      Factory
+	normalizer   *Normalizer
+	displayForms map[string]string
+}
+
+// NewNormalizedFactory is a NormalizedFactory constructor
+func NewNormalizedFactory(factory Factory, normalizer *Normalizer) *NormalizedFactory {
+	return &NormalizedFactory{
+		factory:      factory,
+		normalizer:   normalizer,
+		displayForms: make(map[string]string),
+	}
+}
+
+// LoadDB implements Factory interface. It returns the normalized keys
+func (normalized *NormalizedFactory) LoadDB() ([]string, error) {
+	words, err := normalized.factory.LoadDB()
+	if err != nil {
+		return nil, err
+	}
+	var wordList []string
+	displayForms := make(map[string]string)
+	for _, word := range words {
+		key := normalized.Normalize(word)
+		if key == "" {
+			continue
+		}
+		if _, ok := displayForms[key]; ok {
+			continue
+		}
+		displayForms[key] = word
+		wordList = append(wordList, key)
+	}
+	normalized.displayForms = displayForms
+	return wordList, nil
+}
+
+// Normalize return the key a word is searched with
+func (normalized *NormalizedFactory) Normalize(word string) string {
+	return normalized.normalizer.Normalize(word)
+}
+
+// DisplayForm return the original spelling of a key. Unknown keys
+// are returned as is
+func (normalized *NormalizedFactory) DisplayForm(key string) string {
+	if displayForm, ok := normalized.displayForms[key]; ok {
+		return displayForm
+	}
+	return key
+}
+
+// WordSource return the source of a key if the wrapped Factory is able to tell it
+func (normalized *NormalizedFactory) WordSource(key string) (string, bool) {
+	sourceFactory, ok := normalized.factory.(SourceFactory)
+	if !ok {
+		return "", false
+	}
+	return sourceFactory.WordSource(normalized.DisplayForm(key))
+}
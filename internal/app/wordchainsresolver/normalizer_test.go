@@ -0,0 +1,97 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type NormalizeTestCase struct {
+	description string
+	input       string
+	expected    string
+}
+
+func TestParseNormalizer(t *testing.T) {
+	testCases := []NormalizeTestCase{
+		{
+			description: "lower",
+			input:       "Cat",
+			expected:    "cat",
+		},
+		{
+			description: "nfc,accents,lower",
+			input:       "Été",
+			expected:    "ete",
+		},
+		{
+			description: "lower:tr",
+			input:       "KIZ",
+			expected:    "kız",
+		},
+		{
+			description: "nfd",
+			input:       "é",
+			expected:    "é",
+		},
+		{
+			description: "nfd, nfc",
+			input:       "é",
+			expected:    "é",
+		},
+		{
+			description: "",
+			input:       "Cat",
+			expected:    "Cat",
+		},
+	}
+	for _, test := range testCases {
+		normalizer, err := ParseNormalizer(test.description)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, normalizer.Normalize(test.input), "checking pipeline "+test.description)
+	}
+
+	_, err := ParseNormalizer("nfc,upper")
+	assert.Equal(t, ErrorUnknownNormalizationStep, err)
+}
+
+func TestNormalizedFactory_LoadDB(t *testing.T) {
+	factory := NewNormalizedFactory(&MockListFactory{words: []string{"Été", "ete", "Cat", ""}}, NewNormalizer(FoldAccents, FoldCase("")))
+	wordList, err := factory.LoadDB()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ete", "cat"}, wordList)
+	assert.Equal(t, "Été", factory.DisplayForm("ete"))
+	assert.Equal(t, "Cat", factory.DisplayForm("cat"))
+	assert.Equal(t, "dog", factory.DisplayForm("dog"))
+	assert.Equal(t, "ete", factory.Normalize("ÉTÉ"))
+
+	factory = NewNormalizedFactory(&MockBadFactory{}, NewDefaultNormalizer())
+	_, err = factory.LoadDB()
+	assert.NotNil(t, err)
+}
+
+func TestWordChainsResolver_Normalization(t *testing.T) {
+	factory := NewNormalizedFactory(&MockListFactory{words: []string{"Cat", "cot", "cog", "Dog"}}, NewDefaultNormalizer())
+	wcr := NewWordChainsResolver(NewBFSSolver(), factory)
+	assert.Nil(t, wcr.LoadDB())
+	assert.True(t, wcr.IsWordInDB("CAT"))
+
+	result, err := wcr.Solve("Cat", "DOG")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+	assert.Equal(t, [][]string{{"Cat", "cot", "cog", "Dog"}}, wcr.DisplayChains(result))
+}
+
+func TestWordChainsResolver_AccentedWords(t *testing.T) {
+	factory := NewNormalizedFactory(&MockListFactory{words: []string{"thé", "the", "thy", "été", "êta"}}, NewNormalizer(NFC, FoldCase("")))
+	for _, solver := range []Solver{NewBFSSolver(), NewAStarSolver(), NewGreedySolver(), NewBeamSolver(0)} {
+		wcr := NewWordChainsResolver(solver, factory)
+		assert.Nil(t, wcr.LoadDB())
+		result, err := wcr.Solve("thé", "thy")
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"thé", "thy"}}, result)
+		result, err = wcr.Solve("été", "the")
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	}
+}
@@ -0,0 +1,195 @@
+package wordchainsresolver
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"sort"
+)
+
+var (
+	// ErrorUnknownDifficulty is trigger when a difficulty profile name is unknown
+	ErrorUnknownDifficulty = errors.New("puzzle : unknown difficulty")
+
+	// ErrorNotEnoughPuzzles is trigger when the generator could not find as many
+	// puzzles as asked matching a difficulty profile
+	ErrorNotEnoughPuzzles = errors.New("puzzle : not enough puzzles matching the difficulty")
+)
+
+// DifficultyProfile describes the puzzles of a difficulty level
+type DifficultyProfile struct {
+	Name string
+	// MinSteps and MaxSteps bound the length of the optimal chains, in steps
+	MinSteps int
+	MaxSteps int
+	// MinSolutions and MaxSolutions bound the number of optimal chains,
+	// a zero MaxSolutions means no upper bound
+	MinSolutions int
+	MaxSolutions int
+	// MinCommonness is the minimum frequency percentile of the first and last
+	// words, from 0 for the rarest word to 1 for the most common word.
+	// It is ignored when no frequency list is used
+	MinCommonness float64
+}
+
+var (
+	// EasyProfile gives short puzzles with several solutions between common words
+	EasyProfile = DifficultyProfile{Name: "easy", MinSteps: 2, MaxSteps: 4, MinSolutions: 2, MinCommonness: 0.5}
+	// MediumProfile gives longer puzzles between fairly common words
+	MediumProfile = DifficultyProfile{Name: "medium", MinSteps: 4, MaxSteps: 6, MinSolutions: 1, MinCommonness: 0.25}
+	// HardProfile gives long puzzles with few solutions
+	HardProfile = DifficultyProfile{Name: "hard", MinSteps: 6, MaxSteps: 12, MinSolutions: 1, MaxSolutions: 3}
+)
+
+// GetDifficultyProfile return the difficulty profile named easy, medium or hard
+func GetDifficultyProfile(name string) (DifficultyProfile, error) {
+	for _, profile := range []DifficultyProfile{EasyProfile, MediumProfile, HardProfile} {
+		if profile.Name == name {
+			return profile, nil
+		}
+	}
+	return DifficultyProfile{}, ErrorUnknownDifficulty
+}
+
+// Puzzle is a word ladder to solve from a word to another
+type Puzzle struct {
+	From       string   `json:"from"`
+	To         string   `json:"to"`
+	Steps      int      `json:"steps"`
+	Solutions  int      `json:"solutions"`
+	Difficulty string   `json:"difficulty"`
+	Chain      []string `json:"chain"`
+}
+
+// PuzzleGenerator picks puzzles in a WordGraph. Optimal chains are found with
+// a breadth first walk, so steps and solutions of a puzzle are exact. Given the
+// same graph, frequencies and seed, it generates the same puzzles
+type PuzzleGenerator struct {
+	graph       *WordGraph
+	walker      *graphWalker
+	random      *rand.Rand
+	percentiles map[string]float64
+	// MaxAttempts is the number of first words tried per asked puzzle
+	MaxAttempts int
+}
+
+// NewPuzzleGenerator is a PuzzleGenerator constructor, frequencies can be nil
+func NewPuzzleGenerator(graph *WordGraph, frequencies FrequencyList, seed int64) *PuzzleGenerator {
+	generator := &PuzzleGenerator{
+		graph:       graph,
+		walker:      newGraphWalker(graph),
+		random:      rand.New(rand.NewSource(seed)),
+		MaxAttempts: 100,
+	}
+	if frequencies != nil {
+		generator.percentiles = frequencyPercentiles(graph.words, frequencies)
+	}
+	return generator
+}
+
+func frequencyPercentiles(wordList []string, frequencies FrequencyList) map[string]float64 {
+	sortedWords := make([]string, len(wordList))
+	copy(sortedWords, wordList)
+	sort.SliceStable(sortedWords, func(i, j int) bool {
+		return frequencies[sortedWords[i]] < frequencies[sortedWords[j]]
+	})
+	percentiles := make(map[string]float64, len(sortedWords))
+	// equally frequent words share the rank of the first of them
+	rank := 0
+	for index, word := range sortedWords {
+		if index > 0 && frequencies[word] != frequencies[sortedWords[index-1]] {
+			rank = index
+		}
+		if len(sortedWords) == 1 {
+			percentiles[word] = 1
+			continue
+		}
+		percentiles[word] = float64(rank) / float64(len(sortedWords)-1)
+	}
+	return percentiles
+}
+
+func (generator *PuzzleGenerator) isCommonEnough(word string, profile DifficultyProfile) bool {
+	if generator.percentiles == nil {
+		return true
+	}
+	return generator.percentiles[word] >= profile.MinCommonness
+}
+
+// Generate return count puzzles matching a difficulty profile, made of words of
+// wordLength letters, or of any length if wordLength is zero. If not enough
+// puzzles are found, the puzzles found are returned with ErrorNotEnoughPuzzles
+func (generator *PuzzleGenerator) Generate(profile DifficultyProfile, count int, wordLength int) ([]Puzzle, error) {
+	var firstWords []int
+	for index, word := range generator.graph.words {
+		if wordLength != 0 && len([]rune(word)) != wordLength {
+			continue
+		}
+		if len(generator.graph.neighbors[index]) == 0 || !generator.isCommonEnough(word, profile) {
+			continue
+		}
+		if generator.walker.excluded != nil && generator.walker.excluded[index] {
+			continue
+		}
+		firstWords = append(firstWords, index)
+	}
+
+	var puzzles []Puzzle
+	pairsUsed := make(map[[2]int]interface{})
+	for attempt := 0; len(puzzles) < count && attempt < count*generator.MaxAttempts && len(firstWords) != 0; attempt++ {
+		from := firstWords[generator.random.Intn(len(firstWords))]
+		puzzle, ok := generator.puzzleFrom(from, profile, pairsUsed)
+		if ok {
+			puzzles = append(puzzles, puzzle)
+		}
+	}
+	if len(puzzles) < count {
+		return puzzles, ErrorNotEnoughPuzzles
+	}
+	return puzzles, nil
+}
+
+func (generator *PuzzleGenerator) puzzleFrom(from int, profile DifficultyProfile, pairsUsed map[[2]int]interface{}) (Puzzle, bool) {
+	walker := generator.walker
+	walker.walk(from)
+	chainCounts := walker.chainCounts()
+	var lastWords []int
+	for _, to := range walker.order {
+		steps := walker.distances[to]
+		if steps < profile.MinSteps || steps > profile.MaxSteps {
+			continue
+		}
+		if chainCounts[to] < profile.MinSolutions || (profile.MaxSolutions != 0 && chainCounts[to] > profile.MaxSolutions) {
+			continue
+		}
+		if _, ok := pairsUsed[[2]int{from, to}]; ok || !generator.isCommonEnough(generator.graph.words[to], profile) {
+			continue
+		}
+		lastWords = append(lastWords, to)
+	}
+	if len(lastWords) == 0 {
+		return Puzzle{}, false
+	}
+	to := lastWords[generator.random.Intn(len(lastWords))]
+	pairsUsed[[2]int{from, to}] = nil
+	pairsUsed[[2]int{to, from}] = nil
+	return Puzzle{
+		From:       generator.graph.words[from],
+		To:         generator.graph.words[to],
+		Steps:      walker.distances[to],
+		Solutions:  chainCounts[to],
+		Difficulty: profile.Name,
+		Chain:      walker.chainTo(to),
+	}, true
+}
+
+// ExportPuzzlesJSON writes puzzles as a JSON array
+func ExportPuzzlesJSON(w io.Writer, puzzles []Puzzle) error {
+	if puzzles == nil {
+		puzzles = []Puzzle{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(puzzles)
+}
@@ -0,0 +1,85 @@
+package wordchainsresolver
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDifficultyProfile(t *testing.T) {
+	profile, err := GetDifficultyProfile("hard")
+	assert.Nil(t, err)
+	assert.Equal(t, HardProfile, profile)
+	_, err = GetDifficultyProfile("impossible")
+	assert.Equal(t, ErrorUnknownDifficulty, err)
+}
+
+func TestPuzzleGenerator_Generate(t *testing.T) {
+	wcr := NewWordChainsResolver(NewBFSSolver(), NewFileLoaderFactory(os.Getenv("GOPATH")+"/src/github.com/clnbs/wordChains/assets/app/small_en.txt"))
+	assert.Nil(t, wcr.LoadDB())
+
+	puzzles, err := wcr.NewPuzzleGenerator(42).Generate(EasyProfile, 3, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(puzzles))
+	samePuzzles, err := wcr.NewPuzzleGenerator(42).Generate(EasyProfile, 3, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, puzzles, samePuzzles)
+
+	for _, puzzle := range puzzles {
+		assert.Equal(t, "easy", puzzle.Difficulty)
+		assert.True(t, puzzle.Steps >= EasyProfile.MinSteps && puzzle.Steps <= EasyProfile.MaxSteps)
+		solutions, err := wcr.Solve(puzzle.From, puzzle.To)
+		assert.Nil(t, err)
+		assert.Equal(t, puzzle.Solutions, len(solutions))
+		assert.Equal(t, puzzle.Steps+1, len(solutions[0]))
+		assert.Contains(t, solutions, puzzle.Chain)
+	}
+}
+
+func TestPuzzleGenerator_commonness(t *testing.T) {
+	graph := NewWordGraph([]string{"cat", "cot", "cog", "dog", "dot", "cut", "hut"})
+	frequencies := FrequencyList{"cat": 10, "cot": 10, "dog": 10, "hut": 1}
+	profile := DifficultyProfile{Name: "test", MinSteps: 1, MaxSteps: 10, MinSolutions: 1, MinCommonness: 0.6}
+	puzzles, err := NewPuzzleGenerator(graph, frequencies, 1).Generate(profile, 3, 0)
+	assert.Nil(t, err)
+	for _, puzzle := range puzzles {
+		assert.Contains(t, []string{"cat", "cot", "dog"}, puzzle.From)
+		assert.Contains(t, []string{"cat", "cot", "dog"}, puzzle.To)
+	}
+
+	puzzles, err = NewPuzzleGenerator(graph, frequencies, 1).Generate(profile, 4, 0)
+	assert.Equal(t, ErrorNotEnoughPuzzles, err)
+	assert.Equal(t, 3, len(puzzles))
+}
+
+func TestPuzzleGenerator_rareWords(t *testing.T) {
+	wcr := NewWordChainsResolver(NewBFSSolver(), &MockListFactory{words: []string{"cat", "cot", "cog", "dog", "dot", "cut", "ebb"}})
+	assert.Nil(t, wcr.LoadDB())
+	wcr.UseFrequencies(FrequencyList{"cat": 10, "cot": 10, "cog": 10, "dog": 10}, 5)
+	profile := DifficultyProfile{Name: "test", MinSteps: 3, MaxSteps: 3, MinSolutions: 1}
+
+	// dot and cut are too rare for Solve, so cat and dog are the only 3 steps apart
+	puzzles, err := wcr.NewPuzzleGenerator(1).Generate(profile, 1, 0)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"cat", "dog"}, []string{puzzles[0].From, puzzles[0].To})
+	assert.Equal(t, 1, puzzles[0].Solutions)
+	solutions, err := wcr.Solve(puzzles[0].From, puzzles[0].To)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{puzzles[0].Chain}, solutions)
+}
+
+func TestExportPuzzlesJSON(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	puzzles := []Puzzle{{From: "cat", To: "dog", Steps: 3, Solutions: 2, Difficulty: "easy", Chain: []string{"cat", "cot", "cog", "dog"}}}
+	assert.Nil(t, ExportPuzzlesJSON(buffer, puzzles))
+	var decoded []Puzzle
+	assert.Nil(t, json.Unmarshal(buffer.Bytes(), &decoded))
+	assert.Equal(t, puzzles, decoded)
+
+	buffer.Reset()
+	assert.Nil(t, ExportPuzzlesJSON(buffer, nil))
+	assert.Equal(t, "[]\n", buffer.String())
+}
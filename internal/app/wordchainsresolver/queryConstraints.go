@@ -0,0 +1,196 @@
+package wordchainsresolver
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// ErrorConflictingConstraints is trigger when a first, last or via word is avoided
+var ErrorConflictingConstraints = errors.New("query : a required word is avoided")
+
+// QueryOption is a constraint on the word chains searched by Solve
+type QueryOption func(*queryConstraints)
+
+// CancellableSolver is implemented by solvers stopping their search once a
+// context is done, their FindWordChains then return the context error. A nil
+// context never stops the search
+type CancellableSolver interface {
+	SetContext(ctx context.Context)
+	Context() context.Context
+}
+
+type queryConstraints struct {
+	via           []string
+	avoid         []string
+	avoidPatterns []*regexp.Regexp
+	chain         *ChainConstraint
+	moves         MoveGenerator
+	ctx           context.Context
+}
+
+// Via makes word chains pass through words, in the given order
+func Via(words ...string) QueryOption {
+	return func(constraints *queryConstraints) {
+		constraints.via = append(constraints.via, words...)
+	}
+}
+
+// Avoid makes word chains never use words
+func Avoid(words ...string) QueryOption {
+	return func(constraints *queryConstraints) {
+		constraints.avoid = append(constraints.avoid, words...)
+	}
+}
+
+// AvoidPattern makes word chains never use words matching a regular
+// expression. It is matched against normalized words
+func AvoidPattern(pattern *regexp.Regexp) QueryOption {
+	return func(constraints *queryConstraints) {
+		constraints.avoidPatterns = append(constraints.avoidPatterns, pattern)
+	}
+}
+
+// WithChainConstraint makes the solver generate its moves under a
+// ChainConstraint. The solver must implement the ConstrainedSolver interface
+func WithChainConstraint(constraint ChainConstraint) QueryOption {
+	return func(constraints *queryConstraints) {
+		constraints.chain = &constraint
+	}
+}
+
+// WithMoveGenerator makes the solver generate its moves with a MoveGenerator
+// instead of one letter substitutions. The solver must implement the
+// MoveGeneratingSolver interface
+func WithMoveGenerator(moves MoveGenerator) QueryOption {
+	return func(constraints *queryConstraints) {
+		constraints.moves = moves
+	}
+}
+
+// WithContext stops the search once ctx is done, Solve then return the
+// context error. Solvers which do not implement the CancellableSolver
+// interface finish their search anyway
+func WithContext(ctx context.Context) QueryOption {
+	return func(constraints *queryConstraints) {
+		constraints.ctx = ctx
+	}
+}
+
+func newQueryConstraints(wcr *WordChainsResolver, options []QueryOption) *queryConstraints {
+	constraints := &queryConstraints{}
+	for _, option := range options {
+		option(constraints)
+	}
+	for index, word := range constraints.via {
+		constraints.via[index] = wcr.Normalize(word)
+	}
+	for index, word := range constraints.avoid {
+		constraints.avoid[index] = wcr.Normalize(word)
+	}
+	return constraints
+}
+
+func (constraints *queryConstraints) isAvoided(word string) bool {
+	if isWordInList(word, constraints.avoid) {
+		return true
+	}
+	for _, pattern := range constraints.avoidPatterns {
+		if pattern.MatchString(word) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter return the words of wordList which are not avoided
+func (constraints *queryConstraints) filter(wordList []string) []string {
+	if len(constraints.avoid) == 0 && len(constraints.avoidPatterns) == 0 {
+		return wordList
+	}
+	var keptWords []string
+	for _, word := range wordList {
+		if !constraints.isAvoided(word) {
+			keptWords = append(keptWords, word)
+		}
+	}
+	return keptWords
+}
+
+// acceptedChains return the chains accepted by the chain constraint
+func (constraints *queryConstraints) acceptedChains(chains [][]string) [][]string {
+	var accepted [][]string
+	for _, chain := range chains {
+		if constraints.chain.AcceptsChain(chain) {
+			accepted = append(accepted, chain)
+		}
+	}
+	return accepted
+}
+
+// useSolverSettings makes solver search under the chain constraint, the moves
+// and the context of the query. The query takes the ones of solver it does not
+// set. It return a function giving solver its previous settings back, or an
+// error if the heuristic of solver does not bound the moves
+func (constraints *queryConstraints) useSolverSettings(solver Solver) (func(), error) {
+	// a CachingSolver gives settings to the Solver it decorates
+	solver = decoratedSolver(solver)
+	constrainedSolver, isConstrained := solver.(ConstrainedSolver)
+	if constraints.chain != nil && !isConstrained {
+		return nil, ErrorChainConstraintNotSupported
+	}
+	movesSolver, isMoveGenerating := solver.(MoveGeneratingSolver)
+	if constraints.moves != nil && !isMoveGenerating {
+		return nil, ErrorMoveGeneratorNotSupported
+	}
+	if informedSolver, ok := solver.(InformedSolver); ok {
+		moves := constraints.moves
+		if moves == nil && isMoveGenerating {
+			moves = movesSolver.MoveGenerator()
+		}
+		if err := checkHeuristicMoves(informedSolver.Heuristic(), moves); err != nil {
+			return nil, err
+		}
+	}
+	var restores []func()
+	if isConstrained {
+		previousConstraint := constrainedSolver.ChainConstraint()
+		if constraints.chain == nil {
+			constraints.chain = previousConstraint
+		} else {
+			constrainedSolver.SetChainConstraint(constraints.chain)
+			restores = append(restores, func() { constrainedSolver.SetChainConstraint(previousConstraint) })
+		}
+	}
+	if isMoveGenerating {
+		previousMoves := movesSolver.MoveGenerator()
+		if constraints.moves == nil {
+			constraints.moves = previousMoves
+		} else {
+			movesSolver.SetMoveGenerator(constraints.moves)
+			restores = append(restores, func() { movesSolver.SetMoveGenerator(previousMoves) })
+		}
+	}
+	if cancellableSolver, ok := solver.(CancellableSolver); ok {
+		previousContext := cancellableSolver.Context()
+		if constraints.ctx == nil {
+			constraints.ctx = previousContext
+		} else {
+			cancellableSolver.SetContext(constraints.ctx)
+			restores = append(restores, func() { cancellableSolver.SetContext(previousContext) })
+		}
+	}
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}, nil
+}
+
+// contextError return the error of ctx, or nil if ctx is nil or not done
+func contextError(ctx context.Context) error {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,93 @@
+package wordchainsresolver
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockWordsList_QueryConstraints = []string{"cat", "cot", "cog", "dog", "dot", "cut", "hut", "hot", "hog"}
+
+func assertChainsHave(t *testing.T, result [][]string, length int, usedWords, avoidedWords []string) {
+	assert.NotEqual(t, 0, len(result))
+	for _, chain := range result {
+		assert.Equal(t, length, len(chain), chain)
+		for index := 1; index < len(chain); index++ {
+			assert.True(t, isPossibleNextWord(chain[index], chain[index-1]), chain)
+		}
+		for _, word := range usedWords {
+			assert.Contains(t, chain, word)
+		}
+		for _, word := range avoidedWords {
+			assert.NotContains(t, chain, word)
+		}
+	}
+}
+
+func TestWordChainsResolver_SolveWithConstraints(t *testing.T) {
+	for _, solver := range []Solver{NewBFSSolver(), NewAStarSolver()} {
+		wcr := NewWordChainsResolver(solver, &MockListFactory{words: mockWordsList_QueryConstraints})
+		assert.Nil(t, wcr.LoadDB())
+
+		result, err := wcr.Solve("cat", "dog", Avoid("cot"))
+		assert.Nil(t, err)
+		assertChainsHave(t, result, 6, []string{"cut", "hut", "hot"}, []string{"cot"})
+
+		result, err = wcr.Solve("cat", "dog", AvoidPattern(regexp.MustCompile("^c[ou]t$")))
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+
+		result, err = wcr.Solve("cat", "dog", Via("hot"))
+		assert.Nil(t, err)
+		assertChainsHave(t, result, 5, []string{"cot", "hot"}, nil)
+
+		result, err = wcr.Solve("cat", "dog", Via("hut", "hog"), Avoid("cot"))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"cat", "cut", "hut", "hot", "hog", "dog"}}, result)
+
+		// the shortest chain from cat to dog goes back through cot, the
+		// second segment must be searched without it
+		result, err = wcr.Solve("cot", "dog", Via("cat"), Avoid("cog", "dot"))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"cot", "cat", "cut", "hut", "hot", "hog", "dog"}}, result)
+
+		// a chain going through a via word twice is not a valid chain
+		result, err = wcr.Solve("cat", "dog", Via("hot", "cat"))
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+		result, err = wcr.Solve("cat", "dog", Via("cat", "cat", "hut"))
+		assert.Nil(t, err)
+		assertChainsHave(t, result, 6, []string{"cut", "hut", "hot"}, nil)
+
+		_, err = wcr.Solve("cat", "dog", Via("cot"), Avoid("cot"))
+		assert.Equal(t, ErrorConflictingConstraints, err)
+		_, err = wcr.Solve("cat", "dog", AvoidPattern(regexp.MustCompile("^c")))
+		assert.Equal(t, ErrorConflictingConstraints, err)
+		_, err = wcr.Solve("cat", "dog", Via("www"))
+		assert.Equal(t, ErrorWordNotFoundInDB, err)
+	}
+}
+
+func TestWordChainsResolver_SolveWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, solver := range []Solver{NewBFSSolver(), NewAStarSolver(), NewGreedySolver(), NewBeamSolver(2)} {
+		wcr := NewWordChainsResolver(solver, &MockListFactory{words: mockWordsList_QueryConstraints})
+		assert.Nil(t, wcr.LoadDB())
+
+		_, err := wcr.Solve("cat", "dog", WithContext(ctx))
+		assert.Equal(t, context.Canceled, err)
+		_, err = wcr.Solve("cat", "dog", Via("hut"), WithContext(ctx))
+		assert.Equal(t, context.Canceled, err)
+		_, err = wcr.Solve("cat", "dog", Avoid("cot", "cut"), WithContext(ctx))
+		assert.Equal(t, context.Canceled, err)
+
+		// the context is only used by the query giving it
+		result, err := wcr.Solve("cat", "dog")
+		assert.Nil(t, err)
+		assert.NotEmpty(t, result)
+		assert.Nil(t, solver.(CancellableSolver).Context())
+	}
+}
@@ -0,0 +1,324 @@
+package wordchainsresolver
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrorViaSearchTooLong is trigger when the word chains of a query with via
+// words are not found after exploring maxViaSearchExpansions words
+var ErrorViaSearchTooLong = errors.New("query : too many words explored to go through via words")
+
+const (
+	// maxViaChains bounds the number of word chains given for a query with via words
+	maxViaChains = 1000
+	// maxViaSearchExpansions bounds the number of words explored for a query with via words
+	maxViaSearchExpansions = 1 << 20
+)
+
+// queryGraph tells which words may follow each other in the word chains of a
+// query, given its word list and constraints. The moves of the
+// known move generators are looked up in indexes, one letter substitutions
+// being read from the WordGraph of the loaded database. Words are compared
+// with every candidate word for other move generators
+type queryGraph struct {
+	candidates []string
+	graph      *WordGraph
+	allowed    []bool
+	words      map[string]interface{}
+	deletions  map[string][]string
+	anagrams   map[string][]string
+	scanAll    bool
+	moves      MoveGenerator
+	constraint *ChainConstraint
+	ctx        context.Context
+	goal       string
+	nextWords  map[string][]string
+}
+
+func newQueryGraph(graph *WordGraph, wordList []string, constraints *queryConstraints, from, goal string) *queryGraph {
+	query := &queryGraph{
+		moves:      constraints.moves,
+		constraint: constraints.chain,
+		ctx:        constraints.ctx,
+		goal:       goal,
+		nextWords:  make(map[string][]string),
+	}
+	sameLength := preservesLength(query.moves)
+	fromLength := len([]rune(from))
+	for _, word := range wordList {
+		if !sameLength || len([]rune(word)) == fromLength {
+			query.candidates = append(query.candidates, word)
+		}
+	}
+	query.indexMoves(graph, query.moves)
+	return query
+}
+
+// indexMoves builds the indexes needed to look up the moves of moves
+func (query *queryGraph) indexMoves(graph *WordGraph, moves MoveGenerator) {
+	switch moves := moves.(type) {
+	case nil, SubstitutionMoves:
+		if query.graph != nil {
+			return
+		}
+		query.graph = graph
+		query.allowed = make([]bool, len(graph.words))
+		for _, word := range query.candidates {
+			if index, ok := graph.indexes[word]; ok {
+				query.allowed[index] = true
+			}
+		}
+	case InsertDeleteMoves:
+		if query.deletions != nil {
+			return
+		}
+		// a word is a deletion of the words listed under it
+		query.words = make(map[string]interface{}, len(query.candidates))
+		query.deletions = make(map[string][]string)
+		for _, word := range query.candidates {
+			query.words[word] = nil
+			for _, deletion := range deletions(word) {
+				query.deletions[deletion] = append(query.deletions[deletion], word)
+			}
+		}
+	case AnagramMoves:
+		if query.anagrams != nil {
+			return
+		}
+		query.anagrams = make(map[string][]string)
+		for _, word := range query.candidates {
+			letters := sortedLetters(word)
+			query.anagrams[letters] = append(query.anagrams[letters], word)
+		}
+	case CombinedMoves:
+		for _, generator := range moves {
+			query.indexMoves(graph, generator)
+		}
+	default:
+		query.scanAll = true
+	}
+}
+
+// next return the words which may follow word
+func (query *queryGraph) next(word string) []string {
+	nextWords, ok := query.nextWords[word]
+	if !ok {
+		nextWords = query.linkedWords(word, false)
+		query.nextWords[word] = nextWords
+	}
+	return nextWords
+}
+
+// linkedWords return the words which may follow word, or the words word may
+// follow if backward is set
+func (query *queryGraph) linkedWords(word string, backward bool) []string {
+	possibleWords := query.candidates
+	if !query.scanAll {
+		possibleWords = query.indexedWords(word)
+	}
+	var linkedWords []string
+	for _, otherWord := range possibleWords {
+		if otherWord == word {
+			continue
+		}
+		if backward && allowsMove(query.moves, query.constraint, otherWord, word, query.goal) {
+			linkedWords = append(linkedWords, otherWord)
+		}
+		if !backward && allowsMove(query.moves, query.constraint, word, otherWord, query.goal) {
+			linkedWords = append(linkedWords, otherWord)
+		}
+	}
+	return linkedWords
+}
+
+// indexedWords return the candidate words a move of an indexed move generator
+// links to word, in any direction
+func (query *queryGraph) indexedWords(word string) []string {
+	var indexedWords []string
+	if query.graph != nil {
+		if index, ok := query.graph.indexes[word]; ok {
+			for _, neighbor := range query.graph.neighbors[index] {
+				if query.allowed[neighbor] {
+					indexedWords = append(indexedWords, query.graph.words[neighbor])
+				}
+			}
+		}
+	}
+	if query.deletions != nil {
+		indexedWords = append(indexedWords, query.deletions[word]...)
+		for _, deletion := range deletions(word) {
+			if _, ok := query.words[deletion]; ok && !isWordInList(deletion, indexedWords) {
+				indexedWords = append(indexedWords, deletion)
+			}
+		}
+	}
+	if query.anagrams != nil {
+		indexedWords = append(indexedWords, query.anagrams[sortedLetters(word)]...)
+	}
+	return indexedWords
+}
+
+// reaches tells if a word chain goes from from to to, words may be used twice.
+// It is false once the context of the query is done
+func (query *queryGraph) reaches(from, to string) bool {
+	reached := map[string]interface{}{from: nil}
+	queue := []string{from}
+	for len(queue) != 0 && contextError(query.ctx) == nil {
+		word := queue[0]
+		queue = queue[1:]
+		if word == to {
+			return true
+		}
+		for _, nextWord := range query.linkedWords(word, false) {
+			if _, ok := reached[nextWord]; !ok {
+				reached[nextWord] = nil
+				queue = append(queue, nextWord)
+			}
+		}
+	}
+	return false
+}
+
+// distancesTo return the number of moves from every word reaching word to it
+func (query *queryGraph) distancesTo(word string) map[string]int {
+	distances := map[string]int{word: 0}
+	queue := []string{word}
+	for len(queue) != 0 && contextError(query.ctx) == nil {
+		current := queue[0]
+		queue = queue[1:]
+		for _, previousWord := range query.linkedWords(current, true) {
+			if _, ok := distances[previousWord]; !ok {
+				distances[previousWord] = distances[current] + 1
+				queue = append(queue, previousWord)
+			}
+		}
+	}
+	return distances
+}
+
+// viaSearch looks for the shortest word chains going through required words
+// in order, without using a word twice. It deepens a depth first search, a
+// word being pruned when the moves to the next required word and between the
+// following ones can not fit in the current depth
+type viaSearch struct {
+	query         *queryGraph
+	requiredWords []string
+	// distances are the distances to every required word but the first one
+	distances []map[string]int
+	// remainingMoves are the moves needed from every required word to the last one
+	remainingMoves []int
+	chain          []string
+	used           map[string]interface{}
+	maxMoves       int
+	chains         [][]string
+	expansions     int
+}
+
+// viaChains return the shortest word chains going through every required word
+// in order, the first one being the first word and the last one the last word.
+// A word is never used twice, so nil is return if a required word is repeated
+func (query *queryGraph) viaChains(requiredWords []string) ([][]string, error) {
+	for index, word := range requiredWords {
+		if isWordInList(word, requiredWords[index+1:]) {
+			return nil, nil
+		}
+	}
+	search := &viaSearch{
+		query:          query,
+		requiredWords:  requiredWords,
+		distances:      make([]map[string]int, len(requiredWords)),
+		remainingMoves: make([]int, len(requiredWords)),
+		chain:          []string{requiredWords[0]},
+		used:           map[string]interface{}{requiredWords[0]: nil},
+	}
+	for index := len(requiredWords) - 1; index > 0; index-- {
+		search.distances[index] = query.distancesTo(requiredWords[index])
+		if err := contextError(query.ctx); err != nil {
+			return nil, err
+		}
+		moves, ok := search.distances[index][requiredWords[index-1]]
+		if !ok {
+			return nil, nil
+		}
+		search.remainingMoves[index-1] = search.remainingMoves[index] + moves
+	}
+	// every word of a chain reaches the last word
+	longestChain := len(search.distances[len(requiredWords)-1])
+	for search.maxMoves = search.remainingMoves[0]; search.maxMoves < longestChain; search.maxMoves++ {
+		search.explore(1)
+		if err := contextError(query.ctx); err != nil {
+			return nil, err
+		}
+		if len(search.chains) != 0 {
+			return search.chains, nil
+		}
+		if search.expansions >= maxViaSearchExpansions {
+			return nil, ErrorViaSearchTooLong
+		}
+	}
+	return nil, nil
+}
+
+// explore extends the chain, whose next required word is requiredWords[next]
+func (search *viaSearch) explore(next int) {
+	if next == len(search.requiredWords) {
+		if len(search.chain)-1 == search.maxMoves {
+			search.chains = append(search.chains, append([]string(nil), search.chain...))
+		}
+		return
+	}
+	if search.expansions >= maxViaSearchExpansions || contextError(search.query.ctx) != nil {
+		return
+	}
+	search.expansions++
+	for _, nextWord := range search.query.next(search.chain[len(search.chain)-1]) {
+		if _, ok := search.used[nextWord]; ok {
+			continue
+		}
+		nextRequired := next
+		if nextWord == search.requiredWords[next] {
+			nextRequired++
+		} else if isWordInList(nextWord, search.requiredWords[next:]) {
+			// required words are only used in order
+			continue
+		}
+		if len(search.chain)+search.minMoves(nextWord, nextRequired) > search.maxMoves {
+			continue
+		}
+		search.chain = append(search.chain, nextWord)
+		search.used[nextWord] = nil
+		search.explore(nextRequired)
+		delete(search.used, nextWord)
+		search.chain = search.chain[:len(search.chain)-1]
+		if len(search.chains) == maxViaChains {
+			return
+		}
+	}
+}
+
+// minMoves return a lower bound of the moves from word to the last word,
+// going through the required words from requiredWords[next]
+func (search *viaSearch) minMoves(word string, next int) int {
+	if next == len(search.requiredWords) {
+		return 0
+	}
+	moves, ok := search.distances[next][word]
+	if !ok {
+		return search.maxMoves + 1
+	}
+	return moves + search.remainingMoves[next]
+}
+
+// deletions return the words made by deleting one letter of word
+func deletions(word string) []string {
+	letters := []rune(word)
+	var deletedWords []string
+	for index := range letters {
+		deletedWord := string(letters[:index]) + string(letters[index+1:])
+		if !isWordInList(deletedWord, deletedWords) {
+			deletedWords = append(deletedWords, deletedWord)
+		}
+	}
+	return deletedWords
+}
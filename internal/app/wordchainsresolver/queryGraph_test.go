@@ -0,0 +1,118 @@
+package wordchainsresolver
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockWordsList_QueryGraph = []string{"aaa", "baa", "bba", "bac", "aca", "bca"}
+
+func TestQueryGraph_viaChains(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_QueryGraph)
+	query := newQueryGraph(graph, mockWordsList_QueryGraph, &queryConstraints{}, "aaa", "bac")
+	assert.True(t, query.reaches("aaa", "bac"))
+	assert.Equal(t, map[string]int{"bba": 0, "baa": 1, "bca": 1, "aaa": 2, "aca": 2, "bac": 2}, query.distancesTo("bba"))
+
+	// the shortest chains through bba go back through baa, a longer one is needed
+	result, err := query.viaChains([]string{"aaa", "bba", "bac"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"aaa", "aca", "bca", "bba", "baa", "bac"}}, result)
+
+	result, err = query.viaChains([]string{"aaa", "bac", "bba"})
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	result, err = query.viaChains([]string{"aaa", "bba", "aaa"})
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	query = newQueryGraph(graph, []string{"aaa", "baa", "bba", "bac"}, &queryConstraints{}, "aaa", "bac")
+	assert.Equal(t, []string{"baa"}, query.next("bac"))
+	result, err = query.viaChains([]string{"aaa", "bba", "bac"})
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+}
+
+func TestQueryGraph_indexedMoves(t *testing.T) {
+	words := []string{"cat", "act", "tac", "cant", "can", "cane", "dog"}
+	graph := NewWordGraph(words)
+	query := newQueryGraph(graph, words, &queryConstraints{moves: InsertDeleteMoves{}}, "cat", "cane")
+	assert.Equal(t, []string{"cant"}, query.next("cat"))
+	assert.ElementsMatch(t, []string{"cant", "cane"}, query.next("can"))
+	query = newQueryGraph(graph, words, &queryConstraints{moves: CombineMoves(AnagramMoves{}, SubstitutionMoves{})}, "cat", "tac")
+	assert.ElementsMatch(t, []string{"can", "act", "tac"}, query.next("cat"))
+	assert.False(t, query.reaches("cat", "dog"))
+	query = newQueryGraph(graph, words, &queryConstraints{moves: MoveFunc(func(word, nextWord string) bool { return word[0] == nextWord[0] })}, "cat", "can")
+	assert.ElementsMatch(t, []string{"cant", "can", "cane"}, query.next("cat"))
+	assert.Equal(t, []string{"aat", "cat", "caa"}, deletions("caat"))
+}
+
+func TestWordChainsResolver_SolveUnreachable(t *testing.T) {
+	for _, solver := range []Solver{NewBFSSolver(), NewAStarSolver()} {
+		wcr := NewWordChainsResolver(solver, &MockListFactory{words: mockWordsList_QueryGraph})
+		assert.Nil(t, wcr.LoadDB())
+
+		result, err := wcr.Solve("aaa", "bac", Via("bba"))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"aaa", "aca", "bca", "bba", "baa", "bac"}}, result)
+
+		result, err = wcr.Solve("aaa", "bac", AvoidPattern(regexp.MustCompile("^b.a$")))
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+		result, err = wcr.Solve("aaa", "bac", Via("bca"), Avoid("baa"))
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+		result, err = wcr.Solve("aaa", "bac", WithChainConstraint(ChainConstraint{LockedPositions: []int{2}}))
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+	}
+}
+
+func TestWordChainsResolver_SolveWithRestoresSettings(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockListFactory{words: mockWordsList_QueryConstraints})
+	assert.Nil(t, wcr.LoadDB())
+	solver := NewBFSSolver()
+	constraint := &ChainConstraint{BannedLetters: "o"}
+	solver.SetChainConstraint(constraint)
+
+	// the constraint of the solver is used by queries which do not set one
+	result, err := wcr.SolveWith(solver, "cat", "hut")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cut", "hut"}}, result)
+	result, err = wcr.SolveWith(solver, "cat", "cog")
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	_, err = wcr.SolveWith(solver, "cat", "dog", WithMoveGenerator(SubstitutionMoves{}), WithChainConstraint(ChainConstraint{}))
+	assert.Nil(t, err)
+	assert.Equal(t, constraint, solver.ChainConstraint())
+	assert.Nil(t, solver.MoveGenerator())
+
+	_, err = wcr.SolveWith(NewCachingSolver(&MockCountingSolver{}, "bfs", 10), "cat", "dog", WithChainConstraint(ChainConstraint{}))
+	assert.Equal(t, ErrorChainConstraintNotSupported, err)
+}
+
+func TestWordChainsResolver_SolveComponents(t *testing.T) {
+	solver := &MockCountingSolver{}
+	wcr := NewWordChainsResolver(solver, &MockListFactory{words: []string{"cat", "cot", "dog", "dig"}})
+	assert.Nil(t, wcr.LoadDB())
+
+	// the graph of the database is not built for a plain query
+	result, err := wcr.Solve("cat", "dog")
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, 1, solver.calls)
+	assert.Nil(t, wcr.graph)
+
+	// once built, its components tell the last word is out of reach
+	wcr.Graph()
+	result, err = wcr.Solve("cat", "dog")
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, 1, solver.calls)
+	result, err = wcr.Solve("cat", "cot")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot"}}, result)
+	assert.Equal(t, 2, solver.calls)
+}
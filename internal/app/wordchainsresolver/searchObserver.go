@@ -0,0 +1,209 @@
+package wordchainsresolver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// SearchEventKind tells what happened during a search
+type SearchEventKind string
+
+const (
+	// SearchStarted is sent once, before anything else, with the first word
+	SearchStarted SearchEventKind = "started"
+	// NodeGenerated is sent when a word is added to the tree as a child of Parent
+	NodeGenerated SearchEventKind = "generated"
+	// NodeExpanded is sent when the next words of a word are looked for
+	NodeExpanded SearchEventKind = "expanded"
+	// NodePruned is sent when a word is dropped without being expanded
+	NodePruned SearchEventKind = "pruned"
+	// SolutionFound is sent when the last word is reached, Chain holds the word chain
+	SolutionFound SearchEventKind = "solution"
+	// SearchFinished is sent once, after everything else, with the last word
+	SearchFinished SearchEventKind = "finished"
+)
+
+// SearchEvent is a step of a search. Depth is the number of steps from the first word
+type SearchEvent struct {
+	Kind   SearchEventKind `json:"kind"`
+	Solver string          `json:"solver"`
+	Word   string          `json:"word"`
+	Parent string          `json:"parent,omitempty"`
+	Depth  int             `json:"depth"`
+	Chain  []string        `json:"chain,omitempty"`
+	Time   time.Time       `json:"time"`
+}
+
+// SearchObserver is called by solvers on every search event. Solvers call it
+// synchronously, so a slow observer slows the search down
+type SearchObserver interface {
+	OnSearchEvent(event SearchEvent)
+}
+
+// SearchObserverFunc adapts a function to the SearchObserver interface
+type SearchObserverFunc func(event SearchEvent)
+
+// OnSearchEvent implements SearchObserver interface
+func (observer SearchObserverFunc) OnSearchEvent(event SearchEvent) {
+	observer(event)
+}
+
+// ObservableSolver is implemented by solvers notifying a SearchObserver,
+// a nil observer stops notifications
+type ObservableSolver interface {
+	SetObserver(observer SearchObserver)
+}
+
+// notify sends an event to an observer, if any
+func notify(observer SearchObserver, event SearchEvent) {
+	if observer == nil {
+		return
+	}
+	event.Time = time.Now()
+	observer.OnSearchEvent(event)
+}
+
+// MultiObserver forwards events to several observers, in order
+type MultiObserver []SearchObserver
+
+// OnSearchEvent implements SearchObserver interface
+func (observers MultiObserver) OnSearchEvent(event SearchEvent) {
+	for _, observer := range observers {
+		observer.OnSearchEvent(event)
+	}
+}
+
+// LogObserver writes a line per event
+type LogObserver struct {
+	w     io.Writer
+	mutex sync.Mutex
+}
+
+// NewLogObserver is a LogObserver constructor
+func NewLogObserver(w io.Writer) *LogObserver {
+	return &LogObserver{w: w}
+}
+
+// OnSearchEvent implements SearchObserver interface
+func (observer *LogObserver) OnSearchEvent(event SearchEvent) {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	line := fmt.Sprintf("[%s] %s %s at depth %d", event.Solver, event.Kind, event.Word, event.Depth)
+	if event.Parent != "" {
+		line += " from " + event.Parent
+	}
+	if event.Chain != nil {
+		line += fmt.Sprint(" ", event.Chain)
+	}
+	fmt.Fprintln(observer.w, line)
+}
+
+// CounterObserver counts events by kind
+type CounterObserver struct {
+	counts map[SearchEventKind]int
+	mutex  sync.Mutex
+}
+
+// NewCounterObserver is a CounterObserver constructor
+func NewCounterObserver() *CounterObserver {
+	return &CounterObserver{counts: make(map[SearchEventKind]int)}
+}
+
+// OnSearchEvent implements SearchObserver interface
+func (observer *CounterObserver) OnSearchEvent(event SearchEvent) {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	observer.counts[event.Kind]++
+}
+
+// Count return the number of events of a kind
+func (observer *CounterObserver) Count(kind SearchEventKind) int {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	return observer.counts[kind]
+}
+
+// Reset sets every count back to zero
+func (observer *CounterObserver) Reset() {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	observer.counts = make(map[SearchEventKind]int)
+}
+
+// traceEvent is an event of the Chrome trace event format
+type traceEvent struct {
+	Name      string                 `json:"name"`
+	Category  string                 `json:"cat"`
+	Phase     string                 `json:"ph"`
+	Timestamp int64                  `json:"ts"`
+	ProcessID int                    `json:"pid"`
+	ThreadID  int                    `json:"tid"`
+	Scope     string                 `json:"s,omitempty"`
+	Args      map[string]interface{} `json:"args,omitempty"`
+}
+
+// TraceObserver records events and exports them in the Chrome trace event
+// format, which can be opened in chrome://tracing or Perfetto. Each solver
+// gets its own timeline, where a search is a span and other events are instants
+type TraceObserver struct {
+	events    []traceEvent
+	start     time.Time
+	threadIDs map[string]int
+	mutex     sync.Mutex
+}
+
+// NewTraceObserver is a TraceObserver constructor
+func NewTraceObserver() *TraceObserver {
+	return &TraceObserver{threadIDs: make(map[string]int)}
+}
+
+// OnSearchEvent implements SearchObserver interface
+func (observer *TraceObserver) OnSearchEvent(event SearchEvent) {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	if observer.start.IsZero() {
+		observer.start = event.Time
+	}
+	threadID, ok := observer.threadIDs[event.Solver]
+	if !ok {
+		threadID = len(observer.threadIDs) + 1
+		observer.threadIDs[event.Solver] = threadID
+	}
+	trace := traceEvent{
+		Name:      string(event.Kind) + " " + event.Word,
+		Category:  event.Solver,
+		Phase:     "i",
+		Timestamp: event.Time.Sub(observer.start).Microseconds(),
+		ProcessID: 1,
+		ThreadID:  threadID,
+		Scope:     "t",
+		Args:      map[string]interface{}{"word": event.Word, "depth": event.Depth},
+	}
+	switch event.Kind {
+	case SearchStarted:
+		trace.Name, trace.Phase, trace.Scope = "search", "B", ""
+	case SearchFinished:
+		trace.Name, trace.Phase, trace.Scope = "search", "E", ""
+	}
+	if event.Parent != "" {
+		trace.Args["parent"] = event.Parent
+	}
+	if event.Chain != nil {
+		trace.Args["chain"] = event.Chain
+	}
+	observer.events = append(observer.events, trace)
+}
+
+// Export writes the recorded events as a Chrome trace JSON object
+func (observer *TraceObserver) Export(w io.Writer) error {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	events := observer.events
+	if events == nil {
+		events = []traceEvent{}
+	}
+	return json.NewEncoder(w).Encode(map[string]interface{}{"traceEvents": events})
+}
@@ -0,0 +1,91 @@
+package wordchainsresolver
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservableSolvers(t *testing.T) {
+	solvers := map[string]Solver{
+		"bfs":    NewBFSSolver(),
+		"astar":  NewAStarSolver(),
+		"greedy": NewGreedySolver(),
+		"beam":   NewBeamSolver(2),
+	}
+	for name, solver := range solvers {
+		var events []SearchEvent
+		solver.(ObservableSolver).SetObserver(SearchObserverFunc(func(event SearchEvent) {
+			events = append(events, event)
+		}))
+		_, err := solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+		assert.Nil(t, err, name)
+		assert.Equal(t, SearchStarted, events[0].Kind, name)
+		assert.Equal(t, "cat", events[0].Word, name)
+		assert.Equal(t, SearchFinished, events[len(events)-1].Kind, name)
+
+		counter := NewCounterObserver()
+		solver.(ObservableSolver).SetObserver(counter)
+		_, err = solver.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+		assert.Nil(t, err, name)
+		assert.NotEqual(t, 0, counter.Count(NodeGenerated), name)
+		assert.NotEqual(t, 0, counter.Count(NodeExpanded), name)
+		assert.NotEqual(t, 0, counter.Count(SolutionFound), name)
+		for _, event := range events {
+			assert.Equal(t, name, event.Solver)
+			if event.Kind == SolutionFound {
+				assert.Equal(t, 3, event.Depth, name)
+				assert.Equal(t, "cat", event.Chain[0], name)
+				assert.Equal(t, "dog", event.Chain[3], name)
+			}
+		}
+	}
+}
+
+func TestLogObserver(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	observer := NewLogObserver(buffer)
+	observer.OnSearchEvent(SearchEvent{Kind: NodeGenerated, Solver: "bfs", Word: "cot", Parent: "cat", Depth: 1})
+	observer.OnSearchEvent(SearchEvent{Kind: SolutionFound, Solver: "bfs", Word: "cot", Depth: 1, Chain: []string{"cat", "cot"}})
+	assert.Equal(t, "[bfs] generated cot at depth 1 from cat\n[bfs] solution cot at depth 1 [cat cot]\n", buffer.String())
+}
+
+func TestCounterObserver(t *testing.T) {
+	counter := NewCounterObserver()
+	MultiObserver{counter, counter}.OnSearchEvent(SearchEvent{Kind: NodePruned})
+	assert.Equal(t, 2, counter.Count(NodePruned))
+	assert.Equal(t, 0, counter.Count(NodeExpanded))
+	counter.Reset()
+	assert.Equal(t, 0, counter.Count(NodePruned))
+}
+
+func TestTraceObserver(t *testing.T) {
+	observer := NewTraceObserver()
+	start := time.Now()
+	observer.OnSearchEvent(SearchEvent{Kind: SearchStarted, Solver: "bfs", Word: "cat", Time: start})
+	observer.OnSearchEvent(SearchEvent{Kind: NodeGenerated, Solver: "astar", Word: "cot", Parent: "cat", Depth: 1, Time: start.Add(time.Millisecond)})
+	observer.OnSearchEvent(SearchEvent{Kind: SearchFinished, Solver: "bfs", Word: "dog", Time: start.Add(2 * time.Millisecond)})
+	buffer := &bytes.Buffer{}
+	assert.Nil(t, observer.Export(buffer))
+
+	var trace struct {
+		TraceEvents []map[string]interface{} `json:"traceEvents"`
+	}
+	assert.Nil(t, json.NewDecoder(strings.NewReader(buffer.String())).Decode(&trace))
+	assert.Equal(t, 3, len(trace.TraceEvents))
+	assert.Equal(t, "B", trace.TraceEvents[0]["ph"])
+	assert.Equal(t, "generated cot", trace.TraceEvents[1]["name"])
+	assert.Equal(t, "i", trace.TraceEvents[1]["ph"])
+	assert.Equal(t, float64(1000), trace.TraceEvents[1]["ts"])
+	assert.Equal(t, float64(2), trace.TraceEvents[1]["tid"])
+	assert.Equal(t, "E", trace.TraceEvents[2]["ph"])
+	assert.Equal(t, float64(1), trace.TraceEvents[2]["tid"])
+
+	buffer.Reset()
+	assert.Nil(t, NewTraceObserver().Export(buffer))
+	assert.Equal(t, "{\"traceEvents\":[]}\n", buffer.String())
+}
@@ -0,0 +1,116 @@
+package wordchainsresolver
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrorUnknownTreeFormat is trigger when a search tree format name is unknown
+var ErrorUnknownTreeFormat = errors.New("search tree : unknown format")
+
+// TreeFormat is a format a search tree can be written in
+type TreeFormat string
+
+const (
+	// DOTTreeFormat is the Graphviz format, solution paths are drawn in red
+	DOTTreeFormat TreeFormat = "dot"
+	// ASCIITreeFormat is an indented tree, solution paths are marked with a star
+	ASCIITreeFormat TreeFormat = "ascii"
+)
+
+// ParseTreeFormat return the search tree format named dot or ascii
+func ParseTreeFormat(name string) (TreeFormat, error) {
+	switch format := TreeFormat(strings.ToLower(name)); format {
+	case DOTTreeFormat, ASCIITreeFormat:
+		return format, nil
+	}
+	return "", ErrorUnknownTreeFormat
+}
+
+// SearchTreeNode is a node of the tree explored by a solver. OnSolution
+// is true when the node is on a returned word chain
+type SearchTreeNode struct {
+	Word       string
+	OnSolution bool
+	Children   []*SearchTreeNode
+}
+
+// Size return the number of nodes of the tree
+func (node *SearchTreeNode) Size() int {
+	size := 1
+	for _, child := range node.Children {
+		size += child.Size()
+	}
+	return size
+}
+
+// SearchTreeRecorder is implemented by solvers able to keep the tree explored
+// by their last search. Recording is disabled by default because trees can be huge
+type SearchTreeRecorder interface {
+	RecordSearchTree(record bool)
+	SearchTree() *SearchTreeNode
+}
+
+// WriteSearchTree writes a search tree in a tree format
+func WriteSearchTree(w io.Writer, tree *SearchTreeNode, format TreeFormat) error {
+	writer := bufio.NewWriter(w)
+	switch format {
+	case DOTTreeFormat:
+		fmt.Fprintln(writer, "digraph search {")
+		if tree != nil {
+			nodeCount := 0
+			writeDOTTree(writer, tree, &nodeCount)
+		}
+		fmt.Fprintln(writer, "}")
+	case ASCIITreeFormat:
+		if tree != nil {
+			fmt.Fprintln(writer, asciiTreeLabel(tree))
+			writeASCIITree(writer, tree, "")
+		}
+	default:
+		return ErrorUnknownTreeFormat
+	}
+	return writer.Flush()
+}
+
+// writeDOTTree writes a node and its children, nodes are numbered in
+// depth first order because a word can appear several times in a tree
+func writeDOTTree(writer *bufio.Writer, node *SearchTreeNode, nodeCount *int) int {
+	nodeID := *nodeCount
+	*nodeCount++
+	style := ""
+	if node.OnSolution {
+		style = ", color=red, penwidth=2"
+	}
+	fmt.Fprintf(writer, "  n%d [label=%s%s];\n", nodeID, dotQuote(node.Word), style)
+	for _, child := range node.Children {
+		childID := writeDOTTree(writer, child, nodeCount)
+		style = ""
+		if child.OnSolution {
+			style = " [color=red, penwidth=2]"
+		}
+		fmt.Fprintf(writer, "  n%d -> n%d%s;\n", nodeID, childID, style)
+	}
+	return nodeID
+}
+
+func writeASCIITree(writer *bufio.Writer, node *SearchTreeNode, prefix string) {
+	for index, child := range node.Children {
+		branch, indent := "├── ", "│   "
+		if index == len(node.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintln(writer, prefix+branch+asciiTreeLabel(child))
+		writeASCIITree(writer, child, prefix+indent)
+	}
+}
+
+func asciiTreeLabel(node *SearchTreeNode) string {
+	if node.OnSolution {
+		return node.Word + " *"
+	}
+	return node.Word
+}
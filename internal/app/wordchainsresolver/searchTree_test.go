@@ -0,0 +1,69 @@
+package wordchainsresolver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tree :
+// cat -> cot -> cog -> dog
+// cat -> cot -> dot
+// cat -> cut
+func buildMockSearchTree() *SearchTreeNode {
+	dog := &SearchTreeNode{Word: "dog", OnSolution: true}
+	cog := &SearchTreeNode{Word: "cog", OnSolution: true, Children: []*SearchTreeNode{dog}}
+	dot := &SearchTreeNode{Word: "dot"}
+	cot := &SearchTreeNode{Word: "cot", OnSolution: true, Children: []*SearchTreeNode{cog, dot}}
+	cut := &SearchTreeNode{Word: "cut"}
+	return &SearchTreeNode{Word: "cat", OnSolution: true, Children: []*SearchTreeNode{cot, cut}}
+}
+
+func TestParseTreeFormat(t *testing.T) {
+	format, err := ParseTreeFormat("ASCII")
+	assert.Nil(t, err)
+	assert.Equal(t, ASCIITreeFormat, format)
+	_, err = ParseTreeFormat("gexf")
+	assert.Equal(t, ErrorUnknownTreeFormat, err)
+}
+
+func TestSearchTreeNode_Size(t *testing.T) {
+	assert.Equal(t, 6, buildMockSearchTree().Size())
+}
+
+func TestWriteSearchTree(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	err := WriteSearchTree(buffer, buildMockSearchTree(), ASCIITreeFormat)
+	assert.Nil(t, err)
+	expected := `cat *
+├── cot *
+│   ├── cog *
+│   │   └── dog *
+│   └── dot
+└── cut
+`
+	assert.Equal(t, expected, buffer.String())
+
+	buffer.Reset()
+	err = WriteSearchTree(buffer, buildMockSearchTree(), DOTTreeFormat)
+	assert.Nil(t, err)
+	expected = `digraph search {
+  n0 [label="cat", color=red, penwidth=2];
+  n1 [label="cot", color=red, penwidth=2];
+  n2 [label="cog", color=red, penwidth=2];
+  n3 [label="dog", color=red, penwidth=2];
+  n2 -> n3 [color=red, penwidth=2];
+  n1 -> n2 [color=red, penwidth=2];
+  n4 [label="dot"];
+  n1 -> n4;
+  n0 -> n1 [color=red, penwidth=2];
+  n5 [label="cut"];
+  n0 -> n5;
+}
+`
+	assert.Equal(t, expected, buffer.String())
+
+	err = WriteSearchTree(buffer, nil, TreeFormat("svg"))
+	assert.Equal(t, ErrorUnknownTreeFormat, err)
+}
@@ -0,0 +1,208 @@
+package wordchainsresolver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StoredSolution is a search result saved in a SolutionStore. WordListChecksum
+// identifies the word list the solver searched, which is the dictionary
+// without the words excluded by the query, if any
+type StoredSolution struct {
+	Solver           string     `json:"solver"`
+	WordListChecksum string     `json:"word_list_checksum"`
+	From             string     `json:"from"`
+	To               string     `json:"to"`
+	Chains           [][]string `json:"chains"`
+	SavedAt          time.Time  `json:"saved_at"`
+}
+
+// solutionStoreFile is the JSON content of a SolutionStore file
+type solutionStoreFile struct {
+	Checksum  string           `json:"checksum"`
+	Solutions []StoredSolution `json:"solutions"`
+}
+
+type storeKey struct {
+	solver   string
+	checksum string
+	from     string
+	to       string
+}
+
+func (solution *StoredSolution) key() storeKey {
+	return storeKey{solver: solution.Solver, checksum: solution.WordListChecksum, from: solution.From, to: solution.To}
+}
+
+// SolutionStore keeps search results in a JSON file, for a single dictionary
+// identified by its checksum. A store file written for another dictionary is
+// ignored and overwritten at the next save. Solutions are stored per word list
+// checksum, so queries excluding words from the dictionary are stored too
+type SolutionStore struct {
+	path        string
+	checksum    string
+	solutions   map[storeKey]*StoredSolution
+	invalidated bool
+	now         func() time.Time
+	mutex       sync.Mutex
+}
+
+// OpenSolutionStore loads the store file at path, if it exists, for the
+// dictionary having checksum
+func OpenSolutionStore(path string, checksum string) (*SolutionStore, error) {
+	store := &SolutionStore{
+		path:      path,
+		checksum:  checksum,
+		solutions: make(map[storeKey]*StoredSolution),
+		now:       time.Now,
+	}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var file solutionStoreFile
+	err = json.Unmarshal(content, &file)
+	if err != nil {
+		return nil, err
+	}
+	if file.Checksum != checksum {
+		store.invalidated = true
+		return store, nil
+	}
+	for index := range file.Solutions {
+		solution := file.Solutions[index]
+		store.solutions[solution.key()] = &solution
+	}
+	return store, nil
+}
+
+// Checksum return the checksum of the dictionary the store is for
+func (store *SolutionStore) Checksum() string {
+	return store.checksum
+}
+
+// Invalidated tells if the store file was written for another dictionary,
+// in which case its solutions were dropped
+func (store *SolutionStore) Invalidated() bool {
+	return store.invalidated
+}
+
+// Get return the chains stored for a solver from a word to another, in the
+// word list having checksum
+func (store *SolutionStore) Get(solver, checksum, from, to string) ([][]string, bool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	solution, ok := store.solutions[storeKey{solver: solver, checksum: checksum, from: from, to: to}]
+	if !ok {
+		return nil, false
+	}
+	return copyChains(solution.Chains), true
+}
+
+// Put stores the chains found by a solver from a word to another, in the
+// word list having checksum
+func (store *SolutionStore) Put(solver, checksum, from, to string, chains [][]string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	solution := &StoredSolution{
+		Solver:           solver,
+		WordListChecksum: checksum,
+		From:             from,
+		To:               to,
+		Chains:           copyChains(chains),
+		SavedAt:          store.now().UTC(),
+	}
+	store.solutions[solution.key()] = solution
+}
+
+// Len return the number of stored solutions
+func (store *SolutionStore) Len() int {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return len(store.solutions)
+}
+
+// Solutions return the stored solutions, most recent first
+func (store *SolutionStore) Solutions() []StoredSolution {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.sortedSolutions()
+}
+
+func (store *SolutionStore) sortedSolutions() []StoredSolution {
+	solutions := make([]StoredSolution, 0, len(store.solutions))
+	for _, solution := range store.solutions {
+		solutions = append(solutions, *solution)
+	}
+	sort.Slice(solutions, func(i, j int) bool {
+		if !solutions[i].SavedAt.Equal(solutions[j].SavedAt) {
+			return solutions[i].SavedAt.After(solutions[j].SavedAt)
+		}
+		if solutions[i].Solver != solutions[j].Solver {
+			return solutions[i].Solver < solutions[j].Solver
+		}
+		if solutions[i].WordListChecksum != solutions[j].WordListChecksum {
+			return solutions[i].WordListChecksum < solutions[j].WordListChecksum
+		}
+		if solutions[i].From != solutions[j].From {
+			return solutions[i].From < solutions[j].From
+		}
+		return solutions[i].To < solutions[j].To
+	})
+	return solutions
+}
+
+// Prune removes the solutions saved before olderThan, if it is not zero, then
+// the oldest solutions beyond maxSolutions, if it is not zero. It return the
+// number of removed solutions
+func (store *SolutionStore) Prune(olderThan time.Time, maxSolutions int) int {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	removed := 0
+	for index, solution := range store.sortedSolutions() {
+		tooOld := !olderThan.IsZero() && solution.SavedAt.Before(olderThan)
+		tooMany := maxSolutions > 0 && index >= maxSolutions
+		if tooOld || tooMany {
+			delete(store.solutions, solution.key())
+			removed++
+		}
+	}
+	return removed
+}
+
+// Save writes the store file, readable by everyone. The file is replaced at
+// once, so a crash while saving does not corrupt it
+func (store *SolutionStore) Save() error {
+	store.mutex.Lock()
+	file := solutionStoreFile{Checksum: store.checksum, Solutions: store.sortedSolutions()}
+	store.mutex.Unlock()
+	content, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	temporaryFile, err := ioutil.TempFile(filepath.Dir(store.path), filepath.Base(store.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temporaryFile.Name())
+	_, err = temporaryFile.Write(content)
+	if err == nil {
+		// temporary files are only readable by their owner
+		err = temporaryFile.Chmod(0644)
+	}
+	if closeErr := temporaryFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(temporaryFile.Name(), store.path)
+}
@@ -0,0 +1,110 @@
+package wordchainsresolver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTemporaryStorePath(t *testing.T) (string, func()) {
+	directory, err := ioutil.TempDir("", "wordchains")
+	assert.Nil(t, err)
+	return filepath.Join(directory, "solutions.json"), func() { os.RemoveAll(directory) }
+}
+
+func TestSolutionStore(t *testing.T) {
+	path, remove := newTemporaryStorePath(t)
+	defer remove()
+	store, err := OpenSolutionStore(path, "checksum")
+	assert.Nil(t, err)
+	assert.False(t, store.Invalidated())
+	assert.Equal(t, 0, store.Len())
+
+	store.Put("bfs", "checksum", "cat", "dog", [][]string{{"cat", "cot", "dog"}})
+	chains, ok := store.Get("bfs", "checksum", "cat", "dog")
+	assert.True(t, ok)
+	assert.Equal(t, [][]string{{"cat", "cot", "dog"}}, chains)
+	_, ok = store.Get("astar", "checksum", "cat", "dog")
+	assert.False(t, ok)
+	_, ok = store.Get("bfs", "query checksum", "cat", "dog")
+	assert.False(t, ok)
+	assert.Nil(t, store.Save())
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+	store, err = OpenSolutionStore(path, "checksum")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, store.Len())
+	chains, ok = store.Get("bfs", "checksum", "cat", "dog")
+	assert.True(t, ok)
+	assert.Equal(t, [][]string{{"cat", "cot", "dog"}}, chains)
+
+	store, err = OpenSolutionStore(path, "another checksum")
+	assert.Nil(t, err)
+	assert.True(t, store.Invalidated())
+	assert.Equal(t, 0, store.Len())
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("not json"), 0644))
+	_, err = OpenSolutionStore(path, "checksum")
+	assert.NotNil(t, err)
+}
+
+func TestSolutionStore_Prune(t *testing.T) {
+	store, err := OpenSolutionStore(filepath.Join(os.TempDir(), "does-not-exist", "solutions.json"), "checksum")
+	assert.Nil(t, err)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for index, pair := range [][2]string{{"cat", "dog"}, {"cat", "cot"}, {"cut", "hut"}, {"hut", "hit"}} {
+		store.now = func() time.Time { return start.Add(time.Duration(index) * time.Hour) }
+		store.Put("bfs", "checksum", pair[0], pair[1], nil)
+	}
+	assert.Equal(t, "hut", store.Solutions()[0].From)
+	assert.Equal(t, 1, store.Prune(start.Add(time.Hour), 0))
+	assert.Equal(t, 1, store.Prune(time.Time{}, 2))
+	solutions := store.Solutions()
+	assert.Equal(t, 2, len(solutions))
+	assert.Equal(t, "hut", solutions[0].From)
+	assert.Equal(t, "cut", solutions[1].From)
+	assert.Equal(t, 0, store.Prune(time.Time{}, 0))
+}
+
+func TestCachingSolver_UseStore(t *testing.T) {
+	path, remove := newTemporaryStorePath(t)
+	defer remove()
+	store, err := OpenSolutionStore(path, DictionaryChecksum(mockWordsList_BeamSolver))
+	assert.Nil(t, err)
+	solver := &MockCountingSolver{}
+	cache := NewCachingSolver(solver, "bfs", 10)
+	cache.UseStore(store)
+	_, err = cache.FindWordChains("cat", "dog", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, store.Len())
+
+	// a new process finds the solution in the store
+	cache = NewCachingSolver(solver, "bfs", 10)
+	cache.UseStore(store)
+	result, err := cache.FindWordChains("dog", "cat", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"dog", "cog", "cot", "cat"}, {"dog", "dot", "cot", "cat"}}, result)
+	_, err = cache.FindWordChains("dog", "cat", mockWordsList_BeamSolver)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, solver.calls)
+	assert.Equal(t, CacheStats{Hits: 2, SymmetricHits: 1, StoreHits: 1, Size: 1, Capacity: 10}, cache.Stats())
+
+	// a query excluding words searches another word list, stored apart
+	queryWordList := []string{"cat", "cot", "cog", "dog"}
+	_, err = cache.FindWordChains("cat", "dog", queryWordList)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, solver.calls)
+	assert.Equal(t, 2, store.Len())
+	cache = NewCachingSolver(solver, "bfs", 10)
+	cache.UseStore(store)
+	result, err = cache.FindWordChains("cat", "dog", queryWordList)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+	assert.Equal(t, 2, solver.calls)
+}
@@ -1,6 +1,9 @@
 package wordchainsresolver
 
-import "errors"
+import (
+	"errors"
+	"sync"
+)
 
 var (
 	// ErrorWordLengthDoesNotMatch is trigger when the words enter to create a word chain
@@ -16,6 +19,20 @@ type Factory interface {
 	LoadDB() ([]string, error)
 }
 
+// SourceFactory is a Factory able to tell which source a loaded word comes from
+type SourceFactory interface {
+	Factory
+	WordSource(string) (string, bool)
+}
+
+// NormalizingFactory is a Factory storing words under a normalized key
+// and remembering how they were originally written
+type NormalizingFactory interface {
+	Factory
+	Normalize(string) string
+	DisplayForm(string) string
+}
+
 // Solver handle calculus part of the word chains problem
 type Solver interface {
 	FindWordChains(string, string, []string) ([][]string, error)
@@ -24,9 +41,17 @@ type Solver interface {
 // WordChainsResolver wrap Solver and Factory interfaces by holding
 // the word lis to process
 type WordChainsResolver struct {
-	solver   Solver
-	factory  Factory
-	wordList []string
+	solver          Solver
+	factory         Factory
+	wordList        []string
+	frequencies     FrequencyList
+	minFrequency    int
+	graph           *WordGraph
+	graphMutex      sync.Mutex
+	solveMutex      sync.Mutex
+	targetDistances map[string]map[int]int
+	targetOrder     []string
+	hintMutex       sync.Mutex
 }
 
 // NewWordChainsResolver WordChainsResolver struct constructor
@@ -41,19 +66,131 @@ func (wcr *WordChainsResolver) LoadDB() error {
 	if err != nil {
 		return err
 	}
+	wcr.graphMutex.Lock()
+	wcr.graph = nil
+	wcr.graphMutex.Unlock()
+	wcr.forgetTargets()
 	return nil
 }
 
-// Solve Solver wrapper
-func (wcr *WordChainsResolver) Solve(from, to string) ([][]string, error) {
-	if !isWordInList(from, wcr.wordList) || !isWordInList(to, wcr.wordList) {
-		return nil, ErrorWordNotFoundInDB
+// UseFrequencies makes the resolver rank equally short solutions by commonness.
+// Intermediate words used less than minFrequency times are not used in solutions
+func (wcr *WordChainsResolver) UseFrequencies(frequencies FrequencyList, minFrequency int) {
+	wcr.frequencies = frequencies
+	wcr.minFrequency = minFrequency
+	// hints depend on the excluded rare words
+	wcr.forgetTargets()
+}
+
+// Solve Solver wrapper. Words are normalized before searching. Options
+// constrain the word chains, see Via, Avoid and AvoidPattern. Searches with
+// the Solver of the resolver are made one at a time
+func (wcr *WordChainsResolver) Solve(from, to string, options ...QueryOption) ([][]string, error) {
+	wcr.solveMutex.Lock()
+	defer wcr.solveMutex.Unlock()
+	return wcr.SolveWith(wcr.solver, from, to, options...)
+}
+
+// SolveWith is like Solve but searches with another Solver, which makes it
+// possible to compare solvers on the same loaded database. The Solver must not
+// search for another query meanwhile, its chain constraint and moves are only
+// replaced during the search. Nil is return without calling the Solver when no
+// word chain can reach the last word, which plain queries only check once the
+// graph of the database is built. With via words, the word chains are searched
+// by the resolver itself, on the moves allowed by the Solver settings
+func (wcr *WordChainsResolver) SolveWith(solver Solver, from, to string, options ...QueryOption) ([][]string, error) {
+	from = wcr.Normalize(from)
+	to = wcr.Normalize(to)
+	constraints := newQueryConstraints(wcr, options)
+	requiredWords := append(append([]string{from}, constraints.via...), to)
+	for _, word := range requiredWords {
+		if !isWordInList(word, wcr.wordList) {
+			return nil, ErrorWordNotFoundInDB
+		}
+		if constraints.isAvoided(word) {
+			return nil, ErrorConflictingConstraints
+		}
 	}
-	return wcr.solver.FindWordChains(from, to, wcr.wordList)
+	restoreSettings, err := constraints.useSolverSettings(solver)
+	if err != nil {
+		return nil, err
+	}
+	defer restoreSettings()
+	if constraints.chain != nil {
+		if constraints.chain.ChangeEveryPosition && len(constraints.via) != 0 {
+			return nil, ErrorChainConstraintWithVia
+		}
+		for _, word := range constraints.via {
+			if !constraints.chain.IsAllowedIntermediateWord(word) {
+				return nil, ErrorConflictingConstraints
+			}
+		}
+	}
+	if preservesLength(constraints.moves) {
+		for _, word := range requiredWords {
+			if len([]rune(word)) != len([]rune(from)) {
+				return nil, ErrorWordLengthDoesNotMatch
+			}
+		}
+	}
+	wordList := constraints.filter(wcr.wordList)
+	if wcr.frequencies != nil && wcr.minFrequency > 0 {
+		wordList = wcr.frequencies.excludeRareWords(wordList, wcr.minFrequency, requiredWords...)
+	}
+
+	var solutions [][]string
+	if len(constraints.via) != 0 {
+		query := newQueryGraph(wcr.Graph(), wordList, constraints, from, to)
+		solutions, err = query.viaChains(removeConsecutiveDuplicates(requiredWords))
+	} else if from == to || wcr.mayReach(wordList, constraints, from, to) {
+		solutions, err = solver.FindWordChains(from, to, wordList)
+	} else {
+		err = contextError(constraints.ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(solutions) == 0 {
+		return nil, nil
+	}
+	if constraints.chain != nil {
+		// a chain from a word to itself is never given to the solver
+		solutions = constraints.acceptedChains(solutions)
+	}
+	if wcr.frequencies == nil {
+		return solutions, nil
+	}
+	return wcr.frequencies.RankChains(solutions), nil
+}
+
+// mayReach tells if a word chain may go from from to to. Queries only moving
+// by one letter substitutions through the whole database are checked on the
+// components of its WordGraph if it is already built, the solver finding no
+// word chain otherwise. Other queries are checked on their own queryGraph
+func (wcr *WordChainsResolver) mayReach(wordList []string, constraints *queryConstraints, from, to string) bool {
+	_, isSubstitution := constraints.moves.(SubstitutionMoves)
+	isFiltered := len(constraints.avoid) != 0 || len(constraints.avoidPatterns) != 0
+	if (constraints.moves == nil || isSubstitution) && constraints.chain == nil && !isFiltered {
+		wcr.graphMutex.Lock()
+		graph := wcr.graph
+		wcr.graphMutex.Unlock()
+		if graph == nil {
+			return true
+		}
+		// excluded rare words may only split components
+		return graph.Component(from) != -1 && graph.Component(from) == graph.Component(to)
+	}
+	return newQueryGraph(wcr.Graph(), wordList, constraints, from, to).reaches(from, to)
+}
+
+// DictionaryChecksum return the checksum of the loaded database
+func (wcr *WordChainsResolver) DictionaryChecksum() string {
+	return DictionaryChecksum(wcr.wordList)
 }
 
 // IsWordInDB check if a word is present in the loaded database
 func (wcr *WordChainsResolver) IsWordInDB(w string) bool {
+	w = wcr.Normalize(w)
 	for _, word := range wcr.wordList {
 		if w == word {
 			return true
@@ -62,6 +199,73 @@ func (wcr *WordChainsResolver) IsWordInDB(w string) bool {
 	return false
 }
 
+// Graph return the WordGraph induced by the loaded database. It is built
+// at the first call and kept until the database is loaded again
+func (wcr *WordChainsResolver) Graph() *WordGraph {
+	wcr.graphMutex.Lock()
+	defer wcr.graphMutex.Unlock()
+	if wcr.graph == nil {
+		wcr.graph = NewWordGraph(wcr.wordList)
+	}
+	return wcr.graph
+}
+
+// Stats return statistics of the loaded database graph per word length
+func (wcr *WordChainsResolver) Stats(hubCount int) []LengthStats {
+	return ComputeGraphStats(wcr.Graph(), hubCount)
+}
+
+// HardestPairs return the longest shortest word chains of every component of
+// the loaded database graph
+func (wcr *WordChainsResolver) HardestPairs(options HardestPairsOptions) []ComponentDiameter {
+	return FindHardestPairs(wcr.Graph(), options)
+}
+
+// NewPuzzleGenerator create a PuzzleGenerator picking puzzles in the loaded
+// database, using the frequency list if any. Words too rare to be used by
+// solutions are never used by puzzles, so their steps are the ones of Solve
+func (wcr *WordChainsResolver) NewPuzzleGenerator(seed int64) *PuzzleGenerator {
+	graph := wcr.Graph()
+	generator := NewPuzzleGenerator(graph, wcr.frequencies, seed)
+	generator.walker.excluded = wcr.rareWords(graph)
+	return generator
+}
+
+// Normalize return the key a word is searched with, if the Factory normalizes words
+func (wcr *WordChainsResolver) Normalize(w string) string {
+	normalizingFactory, ok := wcr.factory.(NormalizingFactory)
+	if !ok {
+		return w
+	}
+	return normalizingFactory.Normalize(w)
+}
+
+// DisplayChains return word chains written with the original spelling of their words
+func (wcr *WordChainsResolver) DisplayChains(chains [][]string) [][]string {
+	normalizingFactory, ok := wcr.factory.(NormalizingFactory)
+	if !ok {
+		return chains
+	}
+	displayChains := make([][]string, len(chains))
+	for chainIndex, chain := range chains {
+		displayChains[chainIndex] = make([]string, len(chain))
+		for index, key := range chain {
+			displayChains[chainIndex][index] = normalizingFactory.DisplayForm(key)
+		}
+	}
+	return displayChains
+}
+
+// WordSource return the name of the source a word was loaded from, if the
+// Factory is able to tell it
+func (wcr *WordChainsResolver) WordSource(w string) (string, bool) {
+	sourceFactory, ok := wcr.factory.(SourceFactory)
+	if !ok {
+		return "", false
+	}
+	return sourceFactory.WordSource(wcr.Normalize(w))
+}
+
 // Helpers
 
 func getScoreBetweenTwoWord(word1, word2 string) int {
@@ -80,11 +284,12 @@ func getScoreBetweenTwoWord(word1, word2 string) int {
 }
 
 func isPossibleNextWord(word1, word2 string) bool {
-	if len(word1) == 0 {
+	wordLength := len([]rune(word1))
+	if wordLength == 0 {
 		return false
 	}
 	score := getScoreBetweenTwoWord(word1, word2)
-	return score == len(word1)-1
+	return score == wordLength-1
 }
 
 func excludeStringsFromStrings(strs, bannedWords []string) []string {
@@ -129,3 +334,13 @@ func getBestSolution(solutions [][]string) [][]string {
 	}
 	return bestSolutions
 }
+
+func removeConsecutiveDuplicates(strs []string) []string {
+	var strsWithoutDuplicates []string
+	for index, str := range strs {
+		if index == 0 || str != strs[index-1] {
+			strsWithoutDuplicates = append(strsWithoutDuplicates, str)
+		}
+	}
+	return strsWithoutDuplicates
+}
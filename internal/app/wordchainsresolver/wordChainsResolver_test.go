@@ -73,6 +73,22 @@ func TestWordChainsResolver_Solve(t *testing.T) {
 	GeneralWordChainsResolverTest(&MockSolver{}, &MockFactory{}, t)
 }
 
+func TestWordChainsResolver_DictionaryChecksum(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	assert.Equal(t, DictionaryChecksum([]string{"cat", "cot", "cog", "dog"}), wcr.DictionaryChecksum())
+}
+
+func TestWordChainsResolver_SolveWith(t *testing.T) {
+	wcr := NewWordChainsResolver(nil, &MockFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	result, err := wcr.SolveWith(NewBFSSolver(), "cat", "dog")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result)
+	_, err = wcr.SolveWith(NewBFSSolver(), "www", "dog")
+	assert.Equal(t, ErrorWordNotFoundInDB, err)
+}
+
 func TestExtractSolutionFromNode(t *testing.T) {
 	head := NewGreedyWordTreeElement("test", 0, nil)
 	node := NewGreedyWordTreeElement("test_depth_2", 0, head)
@@ -166,6 +182,16 @@ func TestIsPossibleNextWord(t *testing.T) {
 			word2:    "acd",
 			expected: true,
 		},
+		{
+			word1:    "thé",
+			word2:    "the",
+			expected: true,
+		},
+		{
+			word1:    "été",
+			word2:    "ete",
+			expected: false,
+		},
 		{
 			word1:    "",
 			word2:    "",
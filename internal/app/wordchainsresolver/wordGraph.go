@@ -0,0 +1,281 @@
+package wordchainsresolver
+
+import (
+	"sort"
+	"strconv"
+)
+
+// WordGraph is the graph induced by a word list : words are vertices and two
+// words are linked when they have the same length and differ by exactly one letter
+type WordGraph struct {
+	words          []string
+	indexes        map[string]int
+	neighbors      [][]int
+	components     []int
+	componentSizes []int
+}
+
+// NewWordGraph is a WordGraph constructor. Duplicated words are ignored
+func NewWordGraph(wordList []string) *WordGraph {
+	graph := &WordGraph{indexes: make(map[string]int, len(wordList))}
+	wordsByLength := make(map[int][]int)
+	for _, word := range wordList {
+		if _, ok := graph.indexes[word]; ok || word == "" {
+			continue
+		}
+		index := len(graph.words)
+		graph.indexes[word] = index
+		graph.words = append(graph.words, word)
+		wordLength := len([]rune(word))
+		wordsByLength[wordLength] = append(wordsByLength[wordLength], index)
+	}
+	graph.neighbors = make([][]int, len(graph.words))
+	for _, indexes := range wordsByLength {
+		graph.linkWords(indexes)
+	}
+	// neighbors are sorted to keep graph walks reproducible
+	for _, neighbors := range graph.neighbors {
+		sort.Ints(neighbors)
+	}
+	graph.computeComponents()
+	return graph
+}
+
+// linkWords links words of the same length by gathering them in buckets
+// of words sharing every letter but one
+func (graph *WordGraph) linkWords(indexes []int) {
+	buckets := make(map[string][]int)
+	for _, index := range indexes {
+		chars := []rune(graph.words[index])
+		for position := range chars {
+			key := strconv.Itoa(position) + ":" + string(chars[:position]) + string(chars[position+1:])
+			buckets[key] = append(buckets[key], index)
+		}
+	}
+	for _, bucket := range buckets {
+		for i := 0; i < len(bucket); i++ {
+			for j := i + 1; j < len(bucket); j++ {
+				graph.neighbors[bucket[i]] = append(graph.neighbors[bucket[i]], bucket[j])
+				graph.neighbors[bucket[j]] = append(graph.neighbors[bucket[j]], bucket[i])
+			}
+		}
+	}
+}
+
+func (graph *WordGraph) computeComponents() {
+	graph.components = make([]int, len(graph.words))
+	for index := range graph.components {
+		graph.components[index] = -1
+	}
+	for index := range graph.words {
+		if graph.components[index] != -1 {
+			continue
+		}
+		componentID := len(graph.componentSizes)
+		graph.components[index] = componentID
+		size := 0
+		stack := []int{index}
+		for len(stack) != 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			size++
+			for _, neighbor := range graph.neighbors[current] {
+				if graph.components[neighbor] == -1 {
+					graph.components[neighbor] = componentID
+					stack = append(stack, neighbor)
+				}
+			}
+		}
+		graph.componentSizes = append(graph.componentSizes, size)
+	}
+}
+
+// Words return every word of the graph
+func (graph *WordGraph) Words() []string {
+	return graph.words
+}
+
+// Contains check if a word is a vertex of the graph
+func (graph *WordGraph) Contains(word string) bool {
+	_, ok := graph.indexes[word]
+	return ok
+}
+
+// Neighbors return the words differing by exactly one letter from word
+func (graph *WordGraph) Neighbors(word string) []string {
+	index, ok := graph.indexes[word]
+	if !ok {
+		return nil
+	}
+	neighbors := make([]string, len(graph.neighbors[index]))
+	for neighborIndex, neighbor := range graph.neighbors[index] {
+		neighbors[neighborIndex] = graph.words[neighbor]
+	}
+	return neighbors
+}
+
+// Degree return the number of neighbors of a word
+func (graph *WordGraph) Degree(word string) int {
+	index, ok := graph.indexes[word]
+	if !ok {
+		return 0
+	}
+	return len(graph.neighbors[index])
+}
+
+// Component return the ID of the connected component of a word, or -1 if
+// the word is not in the graph. Two words can be chained if and only if
+// they are in the same component
+func (graph *WordGraph) Component(word string) int {
+	index, ok := graph.indexes[word]
+	if !ok {
+		return -1
+	}
+	return graph.components[index]
+}
+
+// ComponentSize return the number of words in a connected component
+func (graph *WordGraph) ComponentSize(componentID int) int {
+	if componentID < 0 || componentID >= len(graph.componentSizes) {
+		return 0
+	}
+	return graph.componentSizes[componentID]
+}
+
+// WordsOfLength return the words of the graph having length letters
+func (graph *WordGraph) WordsOfLength(length int) []string {
+	var words []string
+	for _, word := range graph.words {
+		if len([]rune(word)) == length {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// Neighborhood return the words reachable from word within steps steps,
+// starting with word and sorted by distance
+func (graph *WordGraph) Neighborhood(word string, steps int) []string {
+	index, ok := graph.indexes[word]
+	if !ok {
+		return nil
+	}
+	walker := newGraphWalker(graph)
+	walker.walk(index)
+	var words []string
+	for _, reached := range walker.order {
+		if walker.distances[reached] > steps {
+			break
+		}
+		words = append(words, graph.words[reached])
+	}
+	return words
+}
+
+// graphWalker runs breadth first walks in a WordGraph. Its buffers are
+// reused from a walk to another, so only the words reached by a walk are
+// touched, which keeps walks in small components cheap
+type graphWalker struct {
+	graph     *WordGraph
+	distances []int
+	parents   []int
+	order     []int
+	// excluded words are never reached by walks, unless they are sources
+	excluded []bool
+	// once stop is true for a reached word, walks only reach the words as
+	// close to the sources as it is
+	stop func(reached int) bool
+}
+
+func newGraphWalker(graph *WordGraph) *graphWalker {
+	walker := &graphWalker{
+		graph:     graph,
+		distances: make([]int, len(graph.words)),
+		parents:   make([]int, len(graph.words)),
+	}
+	for index := range walker.distances {
+		walker.distances[index] = -1
+		walker.parents[index] = -1
+	}
+	return walker
+}
+
+// walk computes the distance of every reachable word from the nearest source.
+// Words are kept in order in which they were reached
+func (walker *graphWalker) walk(sources ...int) {
+	for _, index := range walker.order {
+		walker.distances[index] = -1
+		walker.parents[index] = -1
+	}
+	walker.order = walker.order[:0]
+	stopDistance := -1
+	for _, source := range sources {
+		if walker.distances[source] == -1 {
+			walker.distances[source] = 0
+			walker.order = append(walker.order, source)
+			if walker.stop != nil && walker.stop(source) {
+				stopDistance = 0
+			}
+		}
+	}
+	for next := 0; next < len(walker.order); next++ {
+		current := walker.order[next]
+		if stopDistance != -1 && walker.distances[current] >= stopDistance {
+			break
+		}
+		for _, neighbor := range walker.graph.neighbors[current] {
+			if walker.distances[neighbor] == -1 && (walker.excluded == nil || !walker.excluded[neighbor]) {
+				walker.distances[neighbor] = walker.distances[current] + 1
+				walker.parents[neighbor] = current
+				walker.order = append(walker.order, neighbor)
+				if stopDistance == -1 && walker.stop != nil && walker.stop(neighbor) {
+					stopDistance = walker.distances[neighbor]
+				}
+			}
+		}
+	}
+}
+
+// farthest return the words reached last by the previous walk
+func (walker *graphWalker) farthest() []int {
+	if len(walker.order) == 0 {
+		return nil
+	}
+	maxDistance := walker.distances[walker.order[len(walker.order)-1]]
+	var farthestWords []int
+	for index := len(walker.order) - 1; index >= 0 && walker.distances[walker.order[index]] == maxDistance; index-- {
+		farthestWords = append(farthestWords, walker.order[index])
+	}
+	return farthestWords
+}
+
+// chainTo rebuild the word chain from the nearest source of the previous walk to target
+func (walker *graphWalker) chainTo(target int) []string {
+	var wordChains []string
+	for current := target; current != -1; current = walker.parents[current] {
+		wordChains = append(wordChains, walker.graph.words[current])
+	}
+	return flipStringSlice(wordChains)
+}
+
+// chainCounts return the number of shortest word chains from the sources of
+// the previous walk to every reached word. Counts are capped to avoid overflows
+func (walker *graphWalker) chainCounts() map[int]int {
+	const maxCount = 1 << 30
+	counts := make(map[int]int, len(walker.order))
+	for _, current := range walker.order {
+		if walker.distances[current] == 0 {
+			counts[current] = 1
+			continue
+		}
+		for _, neighbor := range walker.graph.neighbors[current] {
+			if walker.distances[neighbor] == walker.distances[current]-1 {
+				counts[current] += counts[neighbor]
+			}
+		}
+		if counts[current] > maxCount {
+			counts[current] = maxCount
+		}
+	}
+	return counts
+}
@@ -0,0 +1,85 @@
+package wordchainsresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockWordsList_WordGraph = []string{"cat", "cot", "cog", "dog", "dot", "cat", "ebb", "code", "cove", "love", "Ⅰab", "Ⅰac"}
+
+func TestNewWordGraph(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_WordGraph)
+	assert.Equal(t, []string{"cat", "cot", "cog", "dog", "dot", "ebb", "code", "cove", "love", "Ⅰab", "Ⅰac"}, graph.Words())
+	assert.True(t, graph.Contains("cog"))
+	assert.False(t, graph.Contains("cut"))
+
+	assert.Equal(t, []string{"cat", "cog", "dot"}, graph.Neighbors("cot"))
+	assert.Equal(t, []string{"Ⅰac"}, graph.Neighbors("Ⅰab"))
+	assert.Nil(t, graph.Neighbors("cut"))
+	assert.Equal(t, 3, graph.Degree("cot"))
+	assert.Equal(t, 0, graph.Degree("ebb"))
+	assert.Equal(t, 0, graph.Degree("cut"))
+}
+
+func TestWordGraph_Component(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_WordGraph)
+	assert.Equal(t, graph.Component("cat"), graph.Component("dog"))
+	assert.Equal(t, graph.Component("code"), graph.Component("love"))
+	assert.NotEqual(t, graph.Component("cat"), graph.Component("code"))
+	assert.NotEqual(t, graph.Component("cat"), graph.Component("ebb"))
+	assert.Equal(t, -1, graph.Component("cut"))
+
+	assert.Equal(t, 5, graph.ComponentSize(graph.Component("dot")))
+	assert.Equal(t, 3, graph.ComponentSize(graph.Component("cove")))
+	assert.Equal(t, 1, graph.ComponentSize(graph.Component("ebb")))
+	assert.Equal(t, 0, graph.ComponentSize(-1))
+}
+
+func TestGraphWalker(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_WordGraph)
+	walker := newGraphWalker(graph)
+	walker.walk(graph.indexes["cat"])
+	assert.Equal(t, 5, len(walker.order))
+	assert.Equal(t, 3, walker.distances[graph.indexes["dog"]])
+	assert.Equal(t, -1, walker.distances[graph.indexes["code"]])
+	assert.Equal(t, []int{graph.indexes["dog"]}, walker.farthest())
+	assert.Equal(t, []string{"cat", "cot", "cog", "dog"}, walker.chainTo(graph.indexes["dog"]))
+
+	walker.walk(graph.indexes["dog"], graph.indexes["cat"])
+	assert.Equal(t, 1, walker.distances[graph.indexes["dot"]])
+	assert.Equal(t, 3, len(walker.farthest()))
+
+	walker.walk(graph.indexes["code"])
+	assert.Equal(t, -1, walker.distances[graph.indexes["dog"]])
+	assert.Equal(t, []string{"code", "cove", "love"}, walker.chainTo(graph.indexes["love"]))
+
+	walker.stop = func(reached int) bool { return graph.words[reached] == "cot" }
+	walker.walk(graph.indexes["cat"])
+	assert.Equal(t, 2, len(walker.order))
+	assert.Equal(t, -1, walker.distances[graph.indexes["dog"]])
+}
+
+func TestGraphWalker_chainCounts(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_WordGraph)
+	walker := newGraphWalker(graph)
+	walker.walk(graph.indexes["cat"])
+	counts := walker.chainCounts()
+	assert.Equal(t, 1, counts[graph.indexes["cat"]])
+	assert.Equal(t, 1, counts[graph.indexes["cog"]])
+	assert.Equal(t, 2, counts[graph.indexes["dog"]])
+}
+
+func TestWordGraph_WordsOfLength(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_WordGraph)
+	assert.Equal(t, []string{"code", "cove", "love"}, graph.WordsOfLength(4))
+	assert.Nil(t, graph.WordsOfLength(7))
+}
+
+func TestWordGraph_Neighborhood(t *testing.T) {
+	graph := NewWordGraph(mockWordsList_WordGraph)
+	assert.Equal(t, []string{"cat"}, graph.Neighborhood("cat", 0))
+	assert.Equal(t, []string{"cat", "cot", "cog", "dot"}, graph.Neighborhood("cat", 2))
+	assert.Equal(t, []string{"ebb"}, graph.Neighborhood("ebb", 3))
+	assert.Nil(t, graph.Neighborhood("cut", 1))
+}
@@ -0,0 +1,281 @@
+package wordchainsvisualizer
+
+// indexPage is the self-contained visualizer page. Each selected solver gets
+// a panel fed by its own event stream, events are replayed at the chosen
+// speed : words are placed in columns by depth, the frontier is yellow,
+// expanded words are grey, pruned words are pale and solutions are red
+const indexPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Word chains visualizer</title>
+<style>
+body { font-family: sans-serif; margin: 1em; background: #fafafa; color: #222; }
+form { margin-bottom: 1em; }
+form label { margin-right: .8em; }
+#panels { display: flex; flex-wrap: wrap; }
+.panel { flex: 1; min-width: 340px; margin: 0 1em 1em 0; padding: .5em; background: #fff; border: 1px solid #ccc; }
+.panel h2 { margin: .2em 0; font-size: 1.1em; }
+.stats { font-size: .85em; color: #555; }
+.graph { height: 380px; overflow: auto; border: 1px solid #eee; margin: .5em 0; }
+.lists { display: flex; font-size: .8em; }
+.lists div { flex: 1; max-height: 110px; overflow: auto; margin-right: .5em; }
+.chains { font-size: .9em; }
+.error { color: #d33; }
+circle { fill: #f5c542; stroke: #fff; }
+circle.visited { fill: #888; }
+circle.pruned { fill: #ddd; }
+circle.solution { fill: #d33; }
+line { stroke: #ccc; }
+line.solution { stroke: #d33; stroke-width: 2; }
+text { font-size: 10px; fill: #222; }
+</style>
+</head>
+<body>
+<h1>Word chains visualizer</h1>
+<form id="search">
+<label>from <input id="from" value="cat" size="12"></label>
+<label>to <input id="to" value="dog" size="12"></label>
+<label>via <input id="via" size="12"></label>
+<label>avoid <input id="avoid" size="12"></label>
+<label>avoid pattern <input id="avoid-pattern" size="8"></label>
+<span id="solvers"></span>
+<label>speed <input id="speed" type="range" min="1" max="200" value="10"></label>
+<button>Run</button>
+</form>
+<div id="panels"></div>
+<script>
+"use strict";
+var SVG = "http://www.w3.org/2000/svg";
+var COLUMN_WIDTH = 90, ROW_HEIGHT = 14, MARGIN = 20, LIST_LIMIT = 200;
+var panels = [];
+
+function element(namespace, tag, attributes, parent) {
+  var node = namespace ? document.createElementNS(namespace, tag) : document.createElement(tag);
+  for (var name in attributes) {
+    node.setAttribute(name, attributes[name]);
+  }
+  if (parent) {
+    parent.appendChild(node);
+  }
+  return node;
+}
+
+function Panel(solver, query) {
+  this.solver = solver;
+  this.root = element(null, "div", {"class": "panel"}, document.getElementById("panels"));
+  element(null, "h2", {}, this.root).textContent = solver;
+  this.stats = element(null, "div", {"class": "stats"}, this.root);
+  var graph = element(null, "div", {"class": "graph"}, this.root);
+  this.svg = element(SVG, "svg", {width: 0, height: 0}, graph);
+  this.edgeLayer = element(SVG, "g", {}, this.svg);
+  this.nodeLayer = element(SVG, "g", {}, this.svg);
+  var lists = element(null, "div", {"class": "lists"}, this.root);
+  this.frontierList = element(null, "div", {}, lists);
+  this.visitedList = element(null, "div", {}, lists);
+  this.chains = element(null, "div", {"class": "chains"}, this.root);
+  this.nodes = {};
+  this.edges = {};
+  this.columns = [];
+  this.frontier = {};
+  this.visited = {};
+  this.counts = {generated: 0, expanded: 0, pruned: 0, solution: 0};
+  this.queue = [];
+  this.result = null;
+  this.startTime = null;
+  this.duration = null;
+  var panel = this;
+  this.source = new EventSource("/events?solver=" + encodeURIComponent(solver) + query);
+  this.source.addEventListener("search", function (message) {
+    panel.queue.push(JSON.parse(message.data));
+  });
+  this.source.addEventListener("done", function (message) {
+    panel.result = JSON.parse(message.data);
+    panel.source.close();
+  });
+  this.source.onerror = function () {
+    if (!panel.result) {
+      panel.result = {error: "connection lost"};
+    }
+    panel.source.close();
+  };
+}
+
+Panel.prototype.node = function (word, depth) {
+  var node = this.nodes[word];
+  if (node) {
+    return node;
+  }
+  var row = this.columns[depth] || 0;
+  this.columns[depth] = row + 1;
+  node = {x: MARGIN + depth * COLUMN_WIDTH, y: MARGIN + row * ROW_HEIGHT};
+  node.circle = element(SVG, "circle", {cx: node.x, cy: node.y, r: 5}, this.nodeLayer);
+  element(SVG, "title", {}, node.circle).textContent = word + " (depth " + depth + ")";
+  this.nodes[word] = node;
+  var width = MARGIN * 2 + (this.columns.length - 1) * COLUMN_WIDTH + COLUMN_WIDTH;
+  var height = Math.max.apply(null, this.columns.map(function (rows) { return rows || 0; })) * ROW_HEIGHT + MARGIN * 2;
+  this.svg.setAttribute("width", width);
+  this.svg.setAttribute("height", height);
+  return node;
+};
+
+Panel.prototype.label = function (word) {
+  var node = this.nodes[word];
+  if (node && !node.text) {
+    node.text = element(SVG, "text", {x: node.x + 7, y: node.y + 3}, this.nodeLayer);
+    node.text.textContent = word;
+  }
+};
+
+Panel.prototype.edge = function (parent, word) {
+  var key = parent + "\n" + word;
+  if (this.edges[key] || !this.nodes[parent]) {
+    return;
+  }
+  var from = this.nodes[parent], to = this.nodes[word];
+  this.edges[key] = element(SVG, "line", {x1: from.x, y1: from.y, x2: to.x, y2: to.y}, this.edgeLayer);
+};
+
+Panel.prototype.apply = function (event) {
+  var node;
+  switch (event.kind) {
+  case "started":
+    this.startTime = Date.parse(event.time);
+    this.node(event.word, 0);
+    this.label(event.word);
+    this.frontier[event.word] = true;
+    break;
+  case "generated":
+    this.counts.generated++;
+    node = this.node(event.word, event.depth);
+    this.edge(event.parent, event.word);
+    if (!this.visited[event.word]) {
+      this.frontier[event.word] = true;
+    }
+    break;
+  case "expanded":
+    this.counts.expanded++;
+    node = this.node(event.word, event.depth);
+    node.circle.setAttribute("class", "visited");
+    delete this.frontier[event.word];
+    this.visited[event.word] = true;
+    break;
+  case "pruned":
+    this.counts.pruned++;
+    node = this.node(event.word, event.depth);
+    if (!this.visited[event.word]) {
+      node.circle.setAttribute("class", "pruned");
+    }
+    delete this.frontier[event.word];
+    break;
+  case "solution":
+    this.counts.solution++;
+    this.highlight(event.chain || [event.word]);
+    break;
+  case "finished":
+    this.duration = Date.parse(event.time) - this.startTime;
+    break;
+  }
+};
+
+Panel.prototype.highlight = function (chain) {
+  for (var index = 0; index < chain.length; index++) {
+    var node = this.node(chain[index], index);
+    node.circle.setAttribute("class", "solution");
+    this.label(chain[index]);
+    if (index > 0) {
+      this.edge(chain[index - 1], chain[index]);
+      this.edges[chain[index - 1] + "\n" + chain[index]].setAttribute("class", "solution");
+    }
+  }
+};
+
+Panel.prototype.render = function () {
+  var stats = "generated " + this.counts.generated + ", expanded " + this.counts.expanded +
+    ", pruned " + this.counts.pruned + ", solutions " + this.counts.solution;
+  if (this.duration !== null) {
+    stats += ", search took " + this.duration + " ms";
+  }
+  if (this.queue.length) {
+    stats += ", " + this.queue.length + " event(s) to replay";
+  }
+  this.stats.textContent = stats;
+  this.frontierList.textContent = "frontier : " + wordsOf(this.frontier);
+  this.visitedList.textContent = "visited : " + wordsOf(this.visited);
+  if (!this.result || this.queue.length) {
+    return;
+  }
+  this.chains.textContent = "";
+  if (this.result.error) {
+    element(null, "div", {"class": "error"}, this.chains).textContent = this.result.error;
+  }
+  if (this.result.truncated) {
+    element(null, "div", {"class": "error"}, this.chains).textContent =
+      "only the first events of " + this.result.events + " were streamed";
+  }
+  var solutions = this.result.solutions || [];
+  if (!solutions.length && !this.result.error) {
+    element(null, "div", {}, this.chains).textContent = "no solution found";
+  }
+  solutions.forEach(function (chain, index) {
+    element(null, "div", {}, this.chains).textContent = "solution #" + (index + 1) + " : " + chain.join(" -> ");
+  }, this);
+  this.finished = true;
+};
+
+function wordsOf(set) {
+  var words = Object.keys(set);
+  var text = words.slice(0, LIST_LIMIT).join(" ");
+  if (words.length > LIST_LIMIT) {
+    text += " ... (" + words.length + ")";
+  }
+  return text;
+}
+
+function animate() {
+  var speed = parseInt(document.getElementById("speed").value, 10);
+  panels.forEach(function (panel) {
+    if (panel.finished) {
+      return;
+    }
+    for (var count = 0; count < speed && panel.queue.length; count++) {
+      panel.apply(panel.queue.shift());
+    }
+    panel.render();
+  });
+  window.requestAnimationFrame(animate);
+}
+
+fetch("/solvers").then(function (response) {
+  return response.json();
+}).then(function (names) {
+  var solvers = document.getElementById("solvers");
+  names.forEach(function (name) {
+    var label = element(null, "label", {}, solvers);
+    element(null, "input", {type: "checkbox", value: name, checked: "checked"}, label);
+    label.appendChild(document.createTextNode(name));
+  });
+});
+
+document.getElementById("search").addEventListener("submit", function (submitEvent) {
+  submitEvent.preventDefault();
+  panels.forEach(function (panel) {
+    panel.source.close();
+  });
+  panels = [];
+  document.getElementById("panels").textContent = "";
+  var query = "";
+  ["from", "to", "via", "avoid", "avoid-pattern"].forEach(function (name) {
+    query += "&" + name + "=" + encodeURIComponent(document.getElementById(name).value);
+  });
+  var boxes = document.querySelectorAll("#solvers input:checked");
+  for (var index = 0; index < boxes.length; index++) {
+    panels.push(new Panel(boxes[index].value, query));
+  }
+});
+
+window.requestAnimationFrame(animate);
+</script>
+</body>
+</html>
+`
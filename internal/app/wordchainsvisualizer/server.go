@@ -0,0 +1,196 @@
+package wordchainsvisualizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainscli"
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+)
+
+// defaultMaxEvents bounds the number of events streamed per search, a BFS
+// between far words can generate millions of them
+const defaultMaxEvents = 50000
+
+// SolverConstructor creates a new Solver for each search
+type SolverConstructor func() wordchainsresolver.Solver
+
+// Server serves a web page animating solvers and streams their search
+// events over Server-Sent Events
+type Server struct {
+	wcr     *wordchainsresolver.WordChainsResolver
+	solvers map[string]SolverConstructor
+	mux     *http.ServeMux
+	// MaxEvents is the maximum number of events streamed per search, the
+	// result of the search is always sent
+	MaxEvents int
+}
+
+// NewServer is a Server constructor serving BFS, A*, greedy and beam search
+// on the database loaded by wcr
+func NewServer(wcr *wordchainsresolver.WordChainsResolver) *Server {
+	server := &Server{
+		wcr: wcr,
+		solvers: map[string]SolverConstructor{
+			"bfs":    func() wordchainsresolver.Solver { return wordchainsresolver.NewBFSSolver() },
+			"astar":  func() wordchainsresolver.Solver { return wordchainsresolver.NewAStarSolver() },
+			"greedy": func() wordchainsresolver.Solver { return wordchainsresolver.NewGreedySolver() },
+			"beam":   func() wordchainsresolver.Solver { return wordchainsresolver.NewBeamSolver(10) },
+		},
+		mux:       http.NewServeMux(),
+		MaxEvents: defaultMaxEvents,
+	}
+	server.mux.HandleFunc("/", server.handlePage)
+	server.mux.HandleFunc("/solvers", server.handleSolvers)
+	server.mux.HandleFunc("/events", server.handleEvents)
+	server.mux.HandleFunc("/solve", server.handleSolve)
+	return server
+}
+
+// AddSolver makes a solver available under a name. The solver must
+// implement the ObservableSolver interface to be animated
+func (server *Server) AddSolver(name string, constructor SolverConstructor) {
+	server.solvers[name] = constructor
+}
+
+// ServeHTTP implements http.Handler interface
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mux.ServeHTTP(w, r)
+}
+
+func (server *Server) handlePage(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, indexPage)
+}
+
+func (server *Server) handleSolvers(w http.ResponseWriter, r *http.Request) {
+	var names []string
+	for name := range server.solvers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(names)
+}
+
+// searchResult is the last message of a search stream
+type searchResult struct {
+	Solutions [][]string `json:"solutions"`
+	Events    int        `json:"events"`
+	Truncated bool       `json:"truncated"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// eventStream writes Server-Sent Events. Solvers send events from the
+// handler goroutine, the mutex only protects against observers running
+// in other goroutines
+type eventStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	request *http.Request
+	mutex   sync.Mutex
+}
+
+func (stream *eventStream) send(name string, data interface{}) {
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+	if stream.request.Context().Err() != nil {
+		return
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(stream.w, "event: %s\ndata: %s\n\n", name, payload)
+	stream.flusher.Flush()
+}
+
+// queryOptions return the constraints given by the query parameters, which
+// are named after the command line options of the solvers
+func queryOptions(query url.Values) ([]wordchainsresolver.QueryOption, error) {
+	options, err := wordchainscli.QueryOptionsFromValues(query)
+	if err != nil {
+		return nil, err
+	}
+	return options.QueryOptions()
+}
+
+// handleSolve runs a search given by the solver, from, to and constraint
+// query parameters and writes its result as JSON. The search is stopped if
+// the client disconnects
+func (server *Server) handleSolve(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	constructor, ok := server.solvers[query.Get("solver")]
+	if !ok {
+		http.Error(w, "unknown solver", http.StatusBadRequest)
+		return
+	}
+	options, err := queryOptions(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// the search stops when the client goes away
+	options = append(options, wordchainsresolver.WithContext(r.Context()))
+	result := searchResult{}
+	solutions, err := server.wcr.SolveWith(constructor(), query.Get("from"), query.Get("to"), options...)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	result.Solutions = server.wcr.DisplayChains(solutions)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// handleEvents runs a search given by the solver, from, to and constraint
+// query parameters and streams its events, then its result in a "done" event.
+// The search is stopped if the client disconnects
+func (server *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	constructor, ok := server.solvers[query.Get("solver")]
+	if !ok {
+		http.Error(w, "unknown solver", http.StatusBadRequest)
+		return
+	}
+	options, err := queryOptions(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	options = append(options, wordchainsresolver.WithContext(r.Context()))
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	stream := &eventStream{w: w, flusher: flusher, request: r}
+
+	solver := constructor()
+	result := searchResult{}
+	if observableSolver, ok := solver.(wordchainsresolver.ObservableSolver); ok {
+		observableSolver.SetObserver(wordchainsresolver.SearchObserverFunc(func(event wordchainsresolver.SearchEvent) {
+			result.Events++
+			if server.MaxEvents > 0 && result.Events > server.MaxEvents {
+				result.Truncated = true
+				return
+			}
+			stream.send("search", event)
+		}))
+	}
+	solutions, err := server.wcr.SolveWith(solver, query.Get("from"), query.Get("to"), options...)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	result.Solutions = server.wcr.DisplayChains(solutions)
+	stream.send("done", result)
+}
@@ -0,0 +1,172 @@
+package wordchainsvisualizer
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/clnbs/wordChains/internal/app/wordchainsresolver"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockListFactory struct{}
+
+func (factory *MockListFactory) LoadDB() ([]string, error) {
+	return []string{"cat", "cot", "cog", "dog", "dot"}, nil
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, &MockListFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	return httptest.NewServer(NewServer(wcr))
+}
+
+func get(t *testing.T, url string) (*http.Response, string) {
+	response, err := http.Get(url)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	assert.Nil(t, err)
+	return response, string(body)
+}
+
+// readStream return the events of a Server-Sent Events body, in order
+func readStream(body string) (names []string, data []string) {
+	for _, message := range strings.Split(strings.TrimSpace(body), "\n\n") {
+		lines := strings.SplitN(message, "\n", 2)
+		names = append(names, strings.TrimPrefix(lines[0], "event: "))
+		data = append(data, strings.TrimPrefix(lines[1], "data: "))
+	}
+	return names, data
+}
+
+func TestServer_Page(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+	response, body := get(t, server.URL)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, body, "new EventSource")
+
+	response, _ = get(t, server.URL+"/unknown")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	_, body = get(t, server.URL+"/solvers")
+	assert.Equal(t, "[\"astar\",\"beam\",\"bfs\",\"greedy\"]\n", body)
+}
+
+func TestServer_Events(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+	response, body := get(t, server.URL+"/events?solver=bfs&from=cat&to=dog")
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+	names, data := readStream(body)
+	assert.Equal(t, "search", names[0])
+	assert.Equal(t, "done", names[len(names)-1])
+
+	var started wordchainsresolver.SearchEvent
+	assert.Nil(t, json.Unmarshal([]byte(data[0]), &started))
+	assert.Equal(t, wordchainsresolver.SearchStarted, started.Kind)
+	assert.Equal(t, "cat", started.Word)
+
+	var result searchResult
+	assert.Nil(t, json.Unmarshal([]byte(data[len(data)-1]), &result))
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}, {"cat", "cot", "dot", "dog"}}, result.Solutions)
+	assert.Equal(t, len(names)-1, result.Events)
+	assert.False(t, result.Truncated)
+
+	_, body = get(t, server.URL+"/events?solver=astar&from=cat&to=www")
+	names, data = readStream(body)
+	assert.Equal(t, []string{"done"}, names)
+	assert.Contains(t, data[0], wordchainsresolver.ErrorWordNotFoundInDB.Error())
+
+	response, _ = get(t, server.URL+"/events?solver=dijkstra&from=cat&to=dog")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestServer_Solve(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+	response, body := get(t, server.URL+"/solve?solver=bfs&from=cat&to=dog&via=dot")
+	assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+	var result searchResult
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	assert.Equal(t, [][]string{{"cat", "cot", "dot", "dog"}}, result.Solutions)
+
+	_, body = get(t, server.URL+"/solve?solver=astar&from=cat&to=dog&avoid=dot,%20cog")
+	result = searchResult{}
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	assert.Empty(t, result.Solutions)
+
+	_, body = get(t, server.URL+"/events?solver=bfs&from=cat&to=dog&avoid-pattern=^d.t$")
+	names, data := readStream(body)
+	result = searchResult{}
+	assert.Nil(t, json.Unmarshal([]byte(data[len(names)-1]), &result))
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result.Solutions)
+
+	_, body = get(t, server.URL+"/solve?solver=bfs&from=cat&to=dog&lock=0")
+	result = searchResult{}
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	assert.Empty(t, result.Solutions)
+	_, body = get(t, server.URL+"/solve?solver=astar&from=cat&to=dog&banned-letters=d")
+	result = searchResult{}
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	assert.Equal(t, [][]string{{"cat", "cot", "cog", "dog"}}, result.Solutions)
+	_, body = get(t, server.URL+"/solve?solver=bfs&from=cat&to=dog&moves=anagram")
+	result = searchResult{}
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	assert.Empty(t, result.Solutions)
+
+	response, _ = get(t, server.URL+"/solve?solver=bfs&from=cat&to=dog&moves=swap")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response, _ = get(t, server.URL+"/solve?solver=bfs&from=cat&to=dog&avoid-pattern=(")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response, _ = get(t, server.URL+"/events?solver=bfs&from=cat&to=dog&avoid-pattern=(")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response, _ = get(t, server.URL+"/solve?solver=dijkstra&from=cat&to=dog")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestServer_Disconnected(t *testing.T) {
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, &MockListFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	visualizer := NewServer(wcr)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, solver := range []string{"bfs", "astar", "greedy", "beam"} {
+		request := httptest.NewRequest(http.MethodGet, "/solve?from=cat&to=dog&solver="+solver, nil).WithContext(ctx)
+		recorder := httptest.NewRecorder()
+		visualizer.ServeHTTP(recorder, request)
+		var result searchResult
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &result))
+		assert.Equal(t, context.Canceled.Error(), result.Error)
+		assert.Empty(t, result.Solutions)
+
+		// nothing is streamed to a client which went away
+		request = httptest.NewRequest(http.MethodGet, "/events?from=cat&to=dog&solver="+solver, nil).WithContext(ctx)
+		recorder = httptest.NewRecorder()
+		visualizer.ServeHTTP(recorder, request)
+		assert.Empty(t, recorder.Body.String())
+	}
+}
+
+func TestServer_MaxEvents(t *testing.T) {
+	wcr := wordchainsresolver.NewWordChainsResolver(nil, &MockListFactory{})
+	assert.Nil(t, wcr.LoadDB())
+	visualizer := NewServer(wcr)
+	visualizer.MaxEvents = 2
+	visualizer.AddSolver("bfs2", func() wordchainsresolver.Solver { return wordchainsresolver.NewBFSSolver() })
+	server := httptest.NewServer(visualizer)
+	defer server.Close()
+
+	_, body := get(t, server.URL+"/events?solver=bfs2&from=cat&to=dog")
+	names, data := readStream(body)
+	assert.Equal(t, []string{"search", "search", "done"}, names)
+	var result searchResult
+	assert.Nil(t, json.Unmarshal([]byte(data[2]), &result))
+	assert.True(t, result.Truncated)
+	assert.Equal(t, 2, len(result.Solutions))
+}
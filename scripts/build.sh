@@ -53,6 +53,18 @@ if [ -z "$OPTION"  ]; then
   build_from_docker bfs
   build_from_docker greedy
   build_from_docker astar
+  build_from_docker stats
+  build_from_docker hardest
+  build_from_docker puzzles
+  build_from_docker play
+  build_from_docker check
+  build_from_docker beam
+  build_from_docker export
+  build_from_docker visualizer
+  build_from_docker cache
+  build_from_docker reach
+  build_from_docker multi
+  build_from_docker landmarks
   exit 0
 elif [[ "$OPTION" == "greedy" ]]; then
   green echo "Compiling greedy implementation"
@@ -63,4 +75,40 @@ elif [[ "$OPTION" == "bfs" ]]; then
 elif [[ "$OPTION" == "astar" ]]; then
   green echo "Compiling A* implementation"
   build_from_docker astar
+elif [[ "$OPTION" == "stats" ]]; then
+  green echo "Compiling word graph statistics command"
+  build_from_docker stats
+elif [[ "$OPTION" == "hardest" ]]; then
+  green echo "Compiling hardest pairs finder command"
+  build_from_docker hardest
+elif [[ "$OPTION" == "puzzles" ]]; then
+  green echo "Compiling seeded puzzle generator command"
+  build_from_docker puzzles
+elif [[ "$OPTION" == "play" ]]; then
+  green echo "Compiling terminal word ladder game"
+  build_from_docker play
+elif [[ "$OPTION" == "check" ]]; then
+  green echo "Compiling word chain validation command"
+  build_from_docker check
+elif [[ "$OPTION" == "beam" ]]; then
+  green echo "Compiling beam search implementation of word chains solver"
+  build_from_docker beam
+elif [[ "$OPTION" == "export" ]]; then
+  green echo "Compiling word graph export command"
+  build_from_docker export
+elif [[ "$OPTION" == "visualizer" ]]; then
+  green echo "Compiling browser based search visualizer"
+  build_from_docker visualizer
+elif [[ "$OPTION" == "cache" ]]; then
+  green echo "Compiling solution store command"
+  build_from_docker cache
+elif [[ "$OPTION" == "reach" ]]; then
+  green echo "Compiling single source distance map command"
+  build_from_docker reach
+elif [[ "$OPTION" == "multi" ]]; then
+  green echo "Compiling multi source and multi target search command"
+  build_from_docker multi
+elif [[ "$OPTION" == "landmarks" ]]; then
+  green echo "Compiling landmarks preprocessing for the A* heuristic"
+  build_from_docker landmarks
 fi
\ No newline at end of file